@@ -0,0 +1,14 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	"github.com/agntcy/oasf-sdk/cli/commands"
+)
+
+func main() {
+	os.Exit(commands.Execute())
+}
@@ -0,0 +1,399 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	translationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/translation/v1/translationv1grpc"
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
+	"github.com/agntcy/oasf-sdk/validation/report"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	validRecord       = "testdata/valid_record.json"
+	invalidRecord     = "testdata/invalid_record.yaml"
+	translationRecord = "testdata/translation_record.json"
+)
+
+// runCLI runs the CLI with args, reading stdin, and returns its exit code, stdout and stderr.
+func runCLI(t *testing.T, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+
+	rootCmd.SetArgs(args)
+	rootCmd.SetIn(strings.NewReader(stdin))
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetErr(&stderr)
+
+	t.Cleanup(func() {
+		rootCmd.SetArgs(nil)
+		rootCmd.SetIn(nil)
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		resetFlags(rootCmd)
+	})
+
+	return Execute(), stdout.String(), stderr.String()
+}
+
+// resetFlags restores the default values of the flags of cmd and its subcommands,
+// which are otherwise kept between runs.
+func resetFlags(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Changed {
+			return
+		}
+
+		if value, ok := flag.Value.(pflag.SliceValue); ok {
+			_ = value.Replace(nil)
+		} else {
+			_ = flag.Value.Set(flag.DefValue)
+		}
+
+		flag.Changed = false
+	})
+
+	for _, child := range cmd.Commands() {
+		resetFlags(child)
+	}
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+
+	return string(data)
+}
+
+func TestExpandInputs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.json", "b.YAML", "notes.txt", "nested/c.yml"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+
+		if err := os.WriteFile(path, nil, 0o600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		wantErr string
+	}{
+		{name: "no arguments", want: []string{stdinArg}},
+		{name: "stdin", args: []string{"-", filepath.Join(dir, "a.json")}, want: []string{stdinArg, filepath.Join(dir, "a.json")}},
+		{
+			name: "directory",
+			args: []string{dir},
+			want: []string{filepath.Join(dir, "a.json"), filepath.Join(dir, "b.YAML"), filepath.Join(dir, "nested", "c.yml")},
+		},
+		{name: "glob", args: []string{filepath.Join(dir, "*.json")}, want: []string{filepath.Join(dir, "a.json")}},
+		{name: "file of any extension", args: []string{filepath.Join(dir, "notes.txt")}, want: []string{filepath.Join(dir, "notes.txt")}},
+		{
+			name: "duplicates",
+			args: []string{filepath.Join(dir, "a.json"), dir, filepath.Join(dir, "*.json")},
+			want: []string{filepath.Join(dir, "a.json"), filepath.Join(dir, "b.YAML"), filepath.Join(dir, "nested", "c.yml")},
+		},
+		{name: "repeated stdin", args: []string{"-", filepath.Join(dir, "a.json"), "-"}, wantErr: "stdin (\"-\") can only be given once"},
+		{name: "unmatched glob", args: []string{filepath.Join(dir, "*.xml")}, wantErr: "no files match"},
+		{name: "invalid glob", args: []string{"[a"}, wantErr: "invalid pattern"},
+		{name: "missing file", args: []string{filepath.Join(dir, "missing.json")}, wantErr: "failed to read input"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandInputs(tt.args, strings.NewReader(""))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("got error %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to expand inputs: %v", err)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got inputs %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateExitCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		stdin    string
+		args     []string
+		wantCode int
+	}{
+		{name: "valid", args: []string{validRecord}, wantCode: ExitOK},
+		{name: "valid stdin", stdin: readTestFile(t, validRecord), wantCode: ExitOK},
+		{name: "invalid", args: []string{validRecord, invalidRecord}, wantCode: ExitInvalid},
+		{name: "invalid stdin", stdin: readTestFile(t, invalidRecord), args: []string{"-"}, wantCode: ExitInvalid},
+		{name: "syntax error", stdin: "{", wantCode: ExitInvalid},
+		{name: "missing file", args: []string{"testdata/missing.json"}, wantCode: ExitError},
+		{name: "unknown schema version", stdin: `{"schema_version": "v9.9.9"}`, wantCode: ExitError},
+		{name: "unknown output format", args: []string{"--output", "xml", validRecord}, wantCode: ExitError},
+		{name: "unknown fallback", args: []string{"--schema-version-fallback", "major", validRecord}, wantCode: ExitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCLI(t, tt.stdin, append([]string{"validate"}, tt.args...)...)
+			if code != tt.wantCode {
+				t.Errorf("got exit code %d, want %d\nstdout: %s\nstderr: %s", code, tt.wantCode, stdout, stderr)
+			}
+		})
+	}
+}
+
+func TestValidateOutputFormats(t *testing.T) {
+	tests := []struct {
+		output string
+		check  func(t *testing.T, stdout string)
+	}{
+		{
+			output: outputText,
+			check: func(t *testing.T, stdout string) {
+				if !strings.Contains(stdout, invalidRecord+":8:1: error: ") {
+					t.Errorf("got %q, want issues located in the file", stdout)
+				}
+
+				if !strings.HasSuffix(stdout, "1 valid, 1 invalid, 0 failed\n") {
+					t.Errorf("got %q, want a summary line", stdout)
+				}
+			},
+		},
+		{
+			output: outputJSON,
+			check: func(t *testing.T, stdout string) {
+				var results []*report.Result
+				if err := json.Unmarshal([]byte(stdout), &results); err != nil {
+					t.Fatalf("failed to decode results: %v", err)
+				}
+
+				if len(results) != 2 || !results[0].Valid || results[1].Valid || len(results[1].Issues) == 0 {
+					t.Errorf("got results %+v, want a valid and an invalid record", results)
+				}
+			},
+		},
+		{
+			output: outputJSONL,
+			check: func(t *testing.T, stdout string) {
+				var lines []map[string]any
+
+				scanner := bufio.NewScanner(strings.NewReader(stdout))
+				for scanner.Scan() {
+					var line map[string]any
+					if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+						t.Fatalf("failed to decode line %q: %v", scanner.Text(), err)
+					}
+
+					lines = append(lines, line)
+				}
+
+				if len(lines) != 3 || lines[0]["file"] != validRecord || lines[2]["summary"] == nil {
+					t.Errorf("got lines %v, want two results and a summary", lines)
+				}
+			},
+		},
+		{
+			output: outputJUnit,
+			check: func(t *testing.T, stdout string) {
+				var suites struct {
+					Tests    int `xml:"tests,attr"`
+					Failures int `xml:"failures,attr"`
+				}
+				if err := xml.Unmarshal([]byte(stdout), &suites); err != nil {
+					t.Fatalf("failed to decode JUnit report: %v", err)
+				}
+
+				if suites.Tests != 2 || suites.Failures != 1 {
+					t.Errorf("got %+v, want 2 tests and 1 failure", suites)
+				}
+			},
+		},
+		{
+			output: outputSARIF,
+			check: func(t *testing.T, stdout string) {
+				var log struct {
+					Version string `json:"version"`
+					Runs    []struct {
+						Results []any `json:"results"`
+					} `json:"runs"`
+				}
+				if err := json.Unmarshal([]byte(stdout), &log); err != nil {
+					t.Fatalf("failed to decode SARIF log: %v", err)
+				}
+
+				if log.Version != "2.1.0" || len(log.Runs) != 1 || len(log.Runs[0].Results) == 0 {
+					t.Errorf("got SARIF log %+v, want the issues of the invalid record", log)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.output, func(t *testing.T) {
+			code, stdout, stderr := runCLI(t, "", "validate", "--output", tt.output, validRecord, invalidRecord)
+			if code != ExitInvalid {
+				t.Fatalf("got exit code %d, want %d\nstderr: %s", code, ExitInvalid, stderr)
+			}
+
+			tt.check(t, stdout)
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name       string
+		stdin      string
+		args       []string
+		wantCode   int
+		wantOutput string
+		wantErr    string
+	}{
+		{name: "a2a from file", args: []string{"--to", "a2a", translationRecord}, wantOutput: `"skills"`},
+		{name: "vscode from stdin", stdin: readTestFile(t, translationRecord), args: []string{"--to", "vscode"}, wantOutput: `"servers"`},
+		{name: "unknown format", args: []string{"--to", "xml", translationRecord}, wantCode: ExitError, wantErr: "unknown format"},
+		{name: "invalid record", stdin: "- not a record", args: []string{"--to", "a2a", "-"}, wantCode: ExitError, wantErr: "failed to decode record"},
+		{name: "missing format", args: []string{translationRecord}, wantCode: ExitError, wantErr: `"to" not set`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCLI(t, tt.stdin, append([]string{"translate"}, tt.args...)...)
+			if code != tt.wantCode {
+				t.Fatalf("got exit code %d, want %d\nstderr: %s", code, tt.wantCode, stderr)
+			}
+
+			if !strings.Contains(stdout, tt.wantOutput) {
+				t.Errorf("got output %q, want %q", stdout, tt.wantOutput)
+			}
+
+			if !strings.Contains(stderr, tt.wantErr) {
+				t.Errorf("got error %q, want %q", stderr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServerConfigPrint(t *testing.T) {
+	code, stdout, stderr := runCLI(t, "", "server", "config", "print")
+	if code != ExitOK {
+		t.Fatalf("got exit code %d, want %d\nstderr: %s", code, ExitOK, stderr)
+	}
+
+	// Only the options of the validation service are nested under the name of their service.
+	if !strings.Contains(stdout, "\nvalidation:\n") || strings.Contains(stdout, "\ntranslation:\n") {
+		t.Errorf("got configuration %q, want a validation section and no translation section", stdout)
+	}
+}
+
+func TestHealthProbeOfMissingServer(t *testing.T) {
+	address := "unix://" + filepath.Join(t.TempDir(), "missing.sock")
+
+	code, _, stderr := runCLI(t, "", "server", "--health-probe", "--listen-address", address)
+	if code != ExitUnhealthy {
+		t.Errorf("got exit code %d, want %d\nstderr: %s", code, ExitUnhealthy, stderr)
+	}
+}
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+	address := "unix://" + filepath.Join(dir, "grpc.sock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The context of subcommands is only inherited from the root command on their first run.
+	serverCmd.SetContext(ctx)
+	t.Cleanup(func() { serverCmd.SetContext(nil) })
+
+	done := make(chan string, 1)
+	go func() {
+		_, _, stderr := runCLI(t, "", "server",
+			"--listen-address", address,
+			"--http-listen-address", "unix://"+filepath.Join(dir, "http.sock"),
+			"--metrics-listen-address", "unix://"+filepath.Join(dir, "metrics.sock"),
+		)
+		done <- stderr
+	}()
+
+	services := []string{
+		translationv1grpc.TranslationService_ServiceDesc.ServiceName,
+		validationv1grpc.ValidationService_ServiceDesc.ServiceName,
+	}
+
+	for _, service := range services {
+		if err := waitServing(ctx, address, service, done); err != nil {
+			t.Fatalf("service %s is not serving: %v", service, err)
+		}
+	}
+
+	cancel()
+
+	select {
+	case stderr := <-done:
+		if !strings.Contains(stderr, "context cancellation") {
+			t.Errorf("got error %q, want the server stopped by the context", stderr)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("server did not stop")
+	}
+}
+
+// waitServing probes service on the server listening on address until it is serving,
+// or until the server returns the error written to done.
+func waitServing(ctx context.Context, address, service string, done <-chan string) error {
+	cfg := commonserver.Config{ListenAddress: address}
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	timeout := time.After(10 * time.Second)
+
+	for {
+		err := commonserver.Probe(ctx, cfg, service)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case stderr := <-done:
+			return errors.New(stderr)
+		case <-timeout:
+			return err
+		case <-ticker.C:
+		}
+	}
+}
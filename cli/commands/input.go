@@ -0,0 +1,164 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/agntcy/oasf-sdk/validation/report"
+)
+
+const (
+	// stdinArg reads the input from stdin.
+	stdinArg = "-"
+
+	// stdinName is used in place of a file name for input read from stdin.
+	stdinName = report.StdinFile
+)
+
+// recordExtensions are the extensions of the files picked up from directories.
+var recordExtensions = map[string]bool{
+	".json": true,
+	".yaml": true,
+	".yml":  true,
+}
+
+// expandInputs turns the command arguments into a list of files, each listed once.
+// Arguments can be files, directories, glob patterns or "-" for stdin, which can be given once.
+// Stdin is read when no arguments are given and it is not a terminal.
+func expandInputs(args []string, stdin io.Reader) ([]string, error) {
+	if len(args) == 0 {
+		if isTerminal(stdin) {
+			return nil, fmt.Errorf("no input files, pass files or pipe a record on stdin")
+		}
+
+		return []string{stdinArg}, nil
+	}
+
+	var (
+		inputs    []string
+		readStdin bool
+	)
+
+	// Files given more than once, eg. by a directory and a pattern, are only read once.
+	seen := make(map[string]bool)
+	add := func(paths ...string) {
+		for _, path := range paths {
+			if key := filepath.Clean(path); !seen[key] {
+				seen[key] = true
+				inputs = append(inputs, path)
+			}
+		}
+	}
+
+	for _, arg := range args {
+		if arg == stdinArg {
+			// Stdin can only be read once.
+			if readStdin {
+				return nil, fmt.Errorf("stdin (%q) can only be given once", stdinArg)
+			}
+
+			readStdin = true
+			inputs = append(inputs, stdinArg)
+
+			continue
+		}
+
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %w", arg, err)
+			}
+
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no files match %q", arg)
+			}
+
+			add(matches...)
+
+			continue
+		}
+
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read input: %w", err)
+		}
+
+		if !info.IsDir() {
+			add(arg)
+
+			continue
+		}
+
+		files, err := recordFiles(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		add(files...)
+	}
+
+	return inputs, nil
+}
+
+// recordFiles returns the JSON and YAML files found under a directory.
+func recordFiles(dir string) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() && recordExtensions[strings.ToLower(filepath.Ext(path))] {
+			files = append(files, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	return files, nil
+}
+
+// readInput reads a file, or stdin for "-", and returns its display name and contents.
+func readInput(input string, stdin io.Reader) (string, []byte, error) {
+	if input == stdinArg {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return stdinName, nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+
+		return stdinName, data, nil
+	}
+
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return input, nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	return input, data, nil
+}
+
+// isTerminal reports whether r is a terminal. Readers other than files, eg. the stdin of tests, are not.
+func isTerminal(r io.Reader) bool {
+	file, ok := r.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
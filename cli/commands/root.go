@@ -0,0 +1,65 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of the CLI, meant to be checked by CI pipelines and container health checks.
+// Every outcome has its own code, so that scripts can tell them apart.
+const (
+	// ExitOK means that every record is valid, or that the command succeeded.
+	ExitOK = 0
+
+	// ExitInvalid means that at least one record failed validation.
+	ExitInvalid = 1
+
+	// ExitError means that the command could not run, for example because of bad
+	// flags or unreadable input.
+	ExitError = 2
+
+	// ExitUnhealthy means that the server checked by a health probe is not serving.
+	// Container health checks treat any code but 0 as unhealthy.
+	ExitUnhealthy = 3
+)
+
+var (
+	// errInvalid is returned by commands that already reported invalid records.
+	errInvalid = errors.New("invalid records")
+
+	// errUnhealthy is returned by health probes of servers that are not serving.
+	errUnhealthy = errors.New("server is unhealthy")
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "oasf",
+	Short: "OASF SDK command line",
+	Long:  "Validate and translate OASF records without running the SDK servers.",
+
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+// Execute runs the CLI and returns its exit code.
+func Execute() int {
+	err := rootCmd.Execute()
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, errInvalid):
+		return ExitInvalid
+	case errors.Is(err, errUnhealthy):
+		fmt.Fprintf(rootCmd.ErrOrStderr(), "Error: %v\n", err)
+
+		return ExitUnhealthy
+	default:
+		fmt.Fprintf(rootCmd.ErrOrStderr(), "Error: %v\n", err)
+
+		return ExitError
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/agntcy/oasf-sdk/cli/config"
+	commonconfig "github.com/agntcy/oasf-sdk/common/config"
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
+	translationserver "github.com/agntcy/oasf-sdk/translation/server"
+	validationserver "github.com/agntcy/oasf-sdk/validation/server"
+	"github.com/spf13/cobra"
+)
+
+var healthProbe bool
+
+var serverCmd = &cobra.Command{
+	Use:   "server",
+	Short: "Run the SDK services on a single server",
+	Long: `Run any subset of the SDK services on a single gRPC listener and HTTP/JSON gateway.
+
+The server is configured with flags, OASF_SERVER_* environment variables and a YAML configuration file,
+in that order of precedence, eg. --services validation or OASF_SERVER_SERVICES=validation to only serve
+the validation service, or --validation-stream-workers 8 to set an option of a service.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cfg, err := config.LoadConfig(cmd.Flags())
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		if healthProbe {
+			if err := commonserver.Probe(cmd.Context(), cfg.Config, ""); err != nil {
+				return fmt.Errorf("%w: %w", errUnhealthy, err)
+			}
+
+			return nil
+		}
+
+		stopTracing, err := commonserver.SetupTracing(cmd.Context(), cfg.Tracing)
+		if err != nil {
+			return fmt.Errorf("failed to set up tracing: %w", err)
+		}
+		defer stopTracing()
+
+		services, err := newServices(cfg)
+		if err != nil {
+			return err
+		}
+
+		return commonserver.Run(cmd.Context(), cfg.Config, services...)
+	},
+}
+
+var serverConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the server configuration",
+}
+
+var serverConfigPrintCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Print the effective server configuration",
+	Long: `Print the configuration the server would run with, as a YAML configuration file,
+after merging the flags, the environment and the configuration file.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cfg, err := config.LoadConfig(cmd.Flags())
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		data, err := commonconfig.Marshal(cfg, config.Options())
+		if err != nil {
+			return err
+		}
+
+		_, err = cmd.OutOrStdout().Write(data)
+
+		return err
+	},
+}
+
+func init() {
+	config.AddFlags(serverCmd.PersistentFlags())
+	serverCmd.Flags().BoolVar(&healthProbe, "health-probe", false, "check the health of the running server and exit")
+
+	serverConfigCmd.AddCommand(serverConfigPrintCmd)
+	serverCmd.AddCommand(serverConfigCmd)
+	rootCmd.AddCommand(serverCmd)
+}
+
+// newServices creates the services enabled in the configuration.
+func newServices(cfg *config.Config) ([]commonserver.Service, error) {
+	services := make([]commonserver.Service, 0, len(cfg.Services))
+
+	for _, name := range cfg.Services {
+		switch name {
+		case config.ServiceTranslation:
+			services = append(services, translationserver.NewService())
+		case config.ServiceValidation:
+			service, err := validationserver.NewService(&cfg.Validation)
+			if err != nil {
+				return nil, err
+			}
+
+			services = append(services, service)
+		}
+	}
+
+	return services, nil
+}
@@ -0,0 +1,78 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/agntcy/oasf-sdk/client"
+	"github.com/agntcy/oasf-sdk/translation/service"
+	"github.com/spf13/cobra"
+)
+
+var translateOpts struct {
+	to string
+}
+
+var translateCmd = &cobra.Command{
+	Use:   "translate --to <format> [file]",
+	Short: "Translate a record file",
+	Long: fmt.Sprintf(`Translate a JSON or YAML record file into another format and print it to stdout.
+
+Supported formats: %s.
+
+The record is read from stdin when the file is "-" or omitted.`, strings.Join(service.DefaultRegistry().Formats(), ", ")),
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		translator := service.NewTranslationService()
+
+		// Unknown formats are rejected before reading the record.
+		if !slices.Contains(translator.Formats(), translateOpts.to) {
+			return fmt.Errorf("unknown format %q, expected one of: %s", translateOpts.to, strings.Join(translator.Formats(), ", "))
+		}
+
+		inputs, err := expandInputs(args, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		if len(inputs) != 1 {
+			return fmt.Errorf("expected a single record, got %d files", len(inputs))
+		}
+
+		name, data, err := readInput(inputs[0], cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		record, err := client.DecodeRecord(data)
+		if err != nil {
+			return fmt.Errorf("failed to decode record %s: %w", name, err)
+		}
+
+		translated, err := translator.Translate(cmd.Context(), translateOpts.to, record)
+		if err != nil {
+			return fmt.Errorf("failed to translate record %s: %w", name, err)
+		}
+
+		output, err := json.MarshalIndent(translated, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode translation: %w", err)
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(output))
+
+		return err
+	},
+}
+
+func init() {
+	translateCmd.Flags().StringVar(&translateOpts.to, "to", "", "Format to translate to: "+strings.Join(service.DefaultRegistry().Formats(), ", "))
+	_ = translateCmd.MarkFlagRequired("to")
+
+	rootCmd.AddCommand(translateCmd)
+}
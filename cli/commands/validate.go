@@ -0,0 +1,196 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	"github.com/agntcy/oasf-sdk/validation/report"
+	"github.com/agntcy/oasf-sdk/validation/service"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputText  = "text"
+	outputJSON  = "json"
+	outputJSONL = "jsonl"
+	outputJUnit = "junit"
+	outputSARIF = "sarif"
+)
+
+var outputFormats = []string{outputText, outputJSON, outputJSONL, outputJUnit, outputSARIF}
+
+var validateOpts struct {
+	schemaURL             string
+	detectSchemaVersion   bool
+	schemaVersionFallback string
+	output                string
+}
+
+var validateCmd = &cobra.Command{
+	Use:   "validate [files...]",
+	Short: "Validate record files",
+	Long: `Validate JSON or YAML record files against the OASF schema.
+
+Arguments can be files, directories, glob patterns or "-" to read from stdin.
+Stdin is read when no arguments are given.
+
+The command exits with 0 when every record is valid, 1 when at least one
+record is invalid and 2 when the records could not be validated.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputs, err := expandInputs(args, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		fallback, err := service.ParseVersionFallback(validateOpts.schemaVersionFallback)
+		if err != nil {
+			return err
+		}
+
+		validator, err := service.NewValidationService(service.WithVersionFallback(fallback))
+		if err != nil {
+			return fmt.Errorf("failed to create validation service: %w", err)
+		}
+
+		results := make([]*report.Result, 0, len(inputs))
+		for _, input := range inputs {
+			results = append(results, validateFile(cmd.Context(), validator, input, cmd.InOrStdin()))
+		}
+
+		if err := writeResults(cmd.OutOrStdout(), results, validateOpts.output); err != nil {
+			return err
+		}
+
+		return resultsError(results)
+	},
+}
+
+func init() {
+	flags := validateCmd.Flags()
+	flags.StringVar(&validateOpts.schemaURL, "schema-url", "", "Validate against the schema at this URL instead of the embedded schemas")
+	flags.BoolVar(&validateOpts.detectSchemaVersion, "detect-schema-version", false, "Detect the schema version of records with a missing or unknown version")
+	flags.StringVar(&validateOpts.schemaVersionFallback, "schema-version-fallback", string(service.VersionFallbackNone), "Fallback for unknown schema versions: none, patch or minor")
+	flags.StringVarP(&validateOpts.output, "output", "o", outputText, "Output format: "+strings.Join(outputFormats, ", "))
+
+	rootCmd.AddCommand(validateCmd)
+}
+
+func validateFile(ctx context.Context, validator *service.ValidationService, input string, stdin io.Reader) *report.Result {
+	name, data, err := readInput(input, stdin)
+	if err != nil {
+		return &report.Result{File: name, Error: err.Error()}
+	}
+
+	result, err := validator.ValidateRecordDocument(ctx, &validationv1.ValidateRecordDocumentRequest{
+		Document:            data,
+		SchemaUrl:           validateOpts.schemaURL,
+		DetectSchemaVersion: validateOpts.detectSchemaVersion,
+	})
+	if err != nil {
+		return &report.Result{File: name, Error: err.Error()}
+	}
+
+	return result.Report(name)
+}
+
+func writeResults(w io.Writer, results []*report.Result, output string) error {
+	switch output {
+	case outputText:
+		return writeText(w, results)
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+
+		if err := encoder.Encode(results); err != nil {
+			return fmt.Errorf("failed to write results: %w", err)
+		}
+
+		return nil
+	case outputJSONL:
+		return report.WriteJSONL(w, results)
+	case outputJUnit:
+		return report.WriteJUnit(w, results)
+	case outputSARIF:
+		return report.WriteSARIF(w, results)
+	default:
+		return fmt.Errorf("unknown output format %q, expected one of: %s", output, strings.Join(outputFormats, ", "))
+	}
+}
+
+// writeText writes one line per issue in the file:line:column form understood by
+// editors and CI log parsers, followed by a summary line.
+func writeText(w io.Writer, results []*report.Result) error {
+	var valid, invalid, failed int
+
+	for _, result := range results {
+		for _, warning := range result.Warnings {
+			fmt.Fprintf(w, "%s: warning: %s\n", result.File, warning)
+		}
+
+		switch {
+		case result.Error != "":
+			failed++
+
+			fmt.Fprintf(w, "%s: error: %s\n", result.File, result.Error)
+		case result.Valid:
+			valid++
+
+			fmt.Fprintf(w, "%s: valid (schema %s)\n", result.File, result.SchemaVersion)
+		default:
+			invalid++
+
+			for _, issue := range result.Issues {
+				fmt.Fprintf(w, "%s: error: %s\n", issueLocation(result.File, issue), issue)
+			}
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "%d valid, %d invalid, %d failed\n", valid, invalid, failed)
+	if err != nil {
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+
+	return nil
+}
+
+func issueLocation(file string, issue *report.Issue) string {
+	switch {
+	case issue.Line > 0 && issue.Column > 0:
+		return fmt.Sprintf("%s:%d:%d", file, issue.Line, issue.Column)
+	case issue.Line > 0:
+		return fmt.Sprintf("%s:%d", file, issue.Line)
+	default:
+		return file
+	}
+}
+
+// resultsError maps the results to the error that decides the exit code.
+// Files that could not be validated take precedence over invalid records.
+func resultsError(results []*report.Result) error {
+	var invalid, failed int
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			failed++
+		case !result.Valid:
+			invalid++
+		}
+	}
+
+	switch {
+	case failed > 0:
+		return fmt.Errorf("failed to validate %d of %d files", failed, len(results))
+	case invalid > 0:
+		return errInvalid
+	default:
+		return nil
+	}
+}
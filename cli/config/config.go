@@ -0,0 +1,106 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package config holds the configuration of the combined server.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	commonconfig "github.com/agntcy/oasf-sdk/common/config"
+	"github.com/agntcy/oasf-sdk/common/server"
+	validationconfig "github.com/agntcy/oasf-sdk/validation/config"
+	"github.com/spf13/pflag"
+)
+
+const (
+	DefaultEnvPrefix            = "OASF_SERVER"
+	DefaultListenAddress        = "0.0.0.0:31233"
+	DefaultHTTPListenAddress    = "0.0.0.0:31243"
+	DefaultMetricsListenAddress = "0.0.0.0:31253"
+	DefaultServiceName          = "oasf-sdk"
+)
+
+// Names of the services that can be enabled.
+const (
+	ServiceTranslation = "translation"
+	ServiceValidation  = "validation"
+)
+
+// DefaultServices are the services enabled by default.
+var DefaultServices = []string{ServiceTranslation, ServiceValidation}
+
+type Config struct {
+	server.Config `mapstructure:",squash"`
+
+	// Services lists the services to serve on the shared listener.
+	Services []string `json:"services,omitempty" mapstructure:"services"`
+
+	// Validation holds the options of the validation service.
+	// Its listen addresses are not used, the service is served on the shared listener.
+	Validation validationconfig.Config `json:"validation" mapstructure:"validation"`
+}
+
+// LoadConfig loads the configuration from the flags added by AddFlags, if not nil,
+// the environment and the configuration file, and validates it.
+func LoadConfig(flags *pflag.FlagSet) (*Config, error) {
+	config := &Config{}
+	if err := commonconfig.Load(commonconfig.NewViper(DefaultEnvPrefix), Options(), flags, config); err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	return config, nil
+}
+
+// AddFlags adds the flags of the configuration options to flags.
+func AddFlags(flags *pflag.FlagSet) {
+	commonconfig.AddFlags(flags, Options())
+}
+
+// Options returns the options of the combined server.
+func Options() []commonconfig.Option {
+	return slices.Concat(
+		server.Options("", server.Config{
+			ListenAddress:        DefaultListenAddress,
+			HTTPListenAddress:    DefaultHTTPListenAddress,
+			MetricsListenAddress: DefaultMetricsListenAddress,
+			Tracing: server.TracingConfig{
+				SampleRatio: server.DefaultSampleRatio,
+				ServiceName: DefaultServiceName,
+			},
+		}),
+		[]commonconfig.Option{{
+			Key:     "services",
+			Default: DefaultServices,
+			Usage:   "services to serve: translation, validation",
+		}},
+		validationconfig.ServiceOptions(ServiceValidation),
+	)
+}
+
+// Validate checks the server options and the options of the enabled services.
+func (c *Config) Validate() error {
+	errs := []error{c.Config.Validate()}
+
+	if len(c.Services) == 0 {
+		errs = append(errs, errors.New("services: must not be empty"))
+	}
+
+	for _, service := range c.Services {
+		if service != ServiceTranslation && service != ServiceValidation {
+			errs = append(errs, fmt.Errorf("services: unknown service %q, expected one of: %s, %s", service, ServiceTranslation, ServiceValidation))
+		}
+	}
+
+	if slices.Contains(c.Services, ServiceValidation) {
+		errs = append(errs, validationconfig.ValidateServiceConfig(&c.Validation, ServiceValidation))
+	}
+
+	return errors.Join(errs...)
+}
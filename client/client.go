@@ -0,0 +1,191 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package client is a Go client of the validation and translation services.
+//
+// A client connects to a single server, which serves either or both services:
+//
+//	c, err := client.New("localhost:31233", client.WithBearerToken(token))
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//
+//	record, err := client.LoadRecord("record.yaml")
+//	if err != nil {
+//		return err
+//	}
+//
+//	result, err := c.Validate(ctx, record)
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	translationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/translation/v1/translationv1grpc"
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	listenaddress "github.com/agntcy/oasf-sdk/common/address"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Client calls the validation and translation services of a server.
+type Client struct {
+	conn        *grpc.ClientConn
+	validation  validationv1grpc.ValidationServiceClient
+	translation translationv1grpc.TranslationServiceClient
+}
+
+// Option configures a client.
+type Option func(*options)
+
+type options struct {
+	tls         *tls.Config
+	tlsFiles    *tlsFiles
+	token       string
+	retry       RetryPolicy
+	dialOptions []grpc.DialOption
+}
+
+type tlsFiles struct {
+	caFile   string
+	certFile string
+	keyFile  string
+}
+
+// WithTLS connects to the server over TLS with cfg. Connections are not encrypted by default.
+func WithTLS(cfg *tls.Config) Option {
+	return func(o *options) {
+		o.tls = cfg
+	}
+}
+
+// WithTLSFiles connects to the server over TLS, and verifies its certificate with the CA certificates
+// of caFile, or with the system roots when empty. The client certificate and key of certFile and keyFile,
+// if set, are presented to servers that require client certificates.
+func WithTLSFiles(caFile, certFile, keyFile string) Option {
+	return func(o *options) {
+		o.tlsFiles = &tlsFiles{caFile: caFile, certFile: certFile, keyFile: keyFile}
+	}
+}
+
+// WithBearerToken authenticates the requests with token, either an API key or a JWT.
+// The token is sent on connections without TLS too, eg. to a server on a unix socket.
+func WithBearerToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+	}
+}
+
+// WithRetryPolicy sets how failed requests are retried. DefaultRetryPolicy is used by default.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithDialOptions adds gRPC dial options, eg. to set a custom dialer or interceptors.
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, dialOptions...)
+	}
+}
+
+// New creates a client of the server at address, which is either host:port or a unix socket
+// as unix:///path/to/socket, as in the listen addresses of the server. Other gRPC targets,
+// eg. dns:///host:port, are used as is.
+func New(address string, opts ...Option) (*Client, error) {
+	o := options{retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	target, err := listenaddress.ClientTarget(address)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := o.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(o.retry.unaryClientInterceptor()),
+	}
+
+	if o.token != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(bearerToken(o.token)))
+	}
+
+	conn, err := grpc.NewClient(target, append(dialOptions, o.dialOptions...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return &Client{
+		conn:        conn,
+		validation:  validationv1grpc.NewValidationServiceClient(conn),
+		translation: translationv1grpc.NewTranslationServiceClient(conn),
+	}, nil
+}
+
+// Close closes the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (o options) transportCredentials() (credentials.TransportCredentials, error) {
+	if o.tlsFiles == nil {
+		if o.tls == nil {
+			return insecure.NewCredentials(), nil
+		}
+
+		return credentials.NewTLS(o.tls), nil
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if o.tls != nil {
+		cfg = o.tls.Clone()
+	}
+
+	if o.tlsFiles.caFile != "" {
+		caData, err := os.ReadFile(o.tlsFiles.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", o.tlsFiles.caFile)
+		}
+	}
+
+	if o.tlsFiles.certFile != "" || o.tlsFiles.keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.tlsFiles.certFile, o.tlsFiles.keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
+
+// bearerToken sends a token in the authorization metadata of the requests.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (bearerToken) RequireTransportSecurity() bool {
+	return false
+}
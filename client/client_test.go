@@ -0,0 +1,319 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"slices"
+	"testing"
+	"time"
+
+	translationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/translation/v1/translationv1grpc"
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	translationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// validationServer accepts the records with a schema version and rejects the others,
+// as the validation service does for records without a schema version.
+type validationServer struct {
+	validationv1grpc.UnimplementedValidationServiceServer
+}
+
+func (validationServer) ValidateRecord(_ context.Context, req *validationv1.ValidateRecordRequest) (*validationv1.ValidateRecordResponse, error) {
+	if req.GetRecord().GetSchemaVersion() == "" {
+		return nil, status.Error(codes.NotFound, "schema version is not set")
+	}
+
+	return &validationv1.ValidateRecordResponse{IsValid: true, SchemaVersion: req.GetRecord().GetSchemaVersion()}, nil
+}
+
+func (validationServer) ValidateRecordStream(stream validationv1grpc.ValidationService_ValidateRecordStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		resp := &validationv1.ValidateRecordStreamResponse{IsValid: true, SchemaVersion: req.GetRecord().GetSchemaVersion()}
+		if resp.GetSchemaVersion() == "" {
+			resp.IsValid = false
+			resp.Error = &validationv1.ValidateRecordStreamError{Code: int32(codes.NotFound), Message: "schema version is not set"}
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// translationServer translates records into the data of their extension of the format,
+// which has the shape of the translation for the test records.
+type translationServer struct {
+	translationv1grpc.UnimplementedTranslationServiceServer
+}
+
+func (translationServer) RecordToVSCodeCopilot(_ context.Context, req *translationv1.RecordToVSCodeCopilotRequest) (*translationv1.RecordToVSCodeCopilotResponse, error) {
+	data, err := extensionData(req.GetRecord(), "schema.oasf.agntcy.org/features/runtime/mcp", "mcpConfig")
+	if err != nil {
+		return nil, err
+	}
+
+	return &translationv1.RecordToVSCodeCopilotResponse{Data: data}, nil
+}
+
+func (translationServer) RecordToA2A(_ context.Context, req *translationv1.RecordToA2ARequest) (*translationv1.RecordToA2AResponse, error) {
+	data, err := extensionData(req.GetRecord(), "schema.oasf.agntcy.org/features/runtime/a2a", "a2aCard")
+	if err != nil {
+		return nil, err
+	}
+
+	return &translationv1.RecordToA2AResponse{Data: data}, nil
+}
+
+// extensionData returns the data of the extension of a record under key, as in translation responses.
+func extensionData(record *objectsv3.Record, extension, key string) (*structpb.Struct, error) {
+	for _, ext := range record.GetExtensions() {
+		if ext.GetName() == extension {
+			return &structpb.Struct{Fields: map[string]*structpb.Value{key: structpb.NewStructValue(ext.GetData())}}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "record has no %s extension", extension)
+}
+
+// newTestClient serves fakes of the validation and translation services on an in-memory listener,
+// and returns a client of them.
+func newTestClient(t *testing.T, serverOptions []grpc.ServerOption, opts ...Option) *Client {
+	t.Helper()
+
+	server := grpc.NewServer(serverOptions...)
+	validationv1grpc.RegisterValidationServiceServer(server, validationServer{})
+	translationv1grpc.RegisterTranslationServiceServer(server, translationServer{})
+
+	return serveTestClient(t, server, opts...)
+}
+
+// serveTestClient serves server on an in-memory listener and returns a client of it.
+func serveTestClient(t *testing.T, server *grpc.Server, opts ...Option) *Client {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+
+	c, err := New("passthrough:///bufconn", append([]Option{WithDialOptions(dialer)}, opts...)...)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+
+	return c
+}
+
+func loadTestRecord(t *testing.T, path string) *objectsv3.Record {
+	t.Helper()
+
+	record, err := LoadRecord(path)
+	if err != nil {
+		t.Fatalf("failed to load record: %v", err)
+	}
+
+	return record
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t, nil)
+
+	result, err := c.Validate(t.Context(), loadTestRecord(t, "testdata/record_v0.6.0.json"))
+	if err != nil {
+		t.Fatalf("failed to validate record: %v", err)
+	}
+
+	if !result.Valid {
+		t.Errorf("got errors %v, want a valid record", result.Errors)
+	}
+
+	if result.SchemaVersion != "v0.6.0" {
+		t.Errorf("got schema version %q, want v0.6.0", result.SchemaVersion)
+	}
+
+	// Records without a schema version have no schema unless detected.
+	_, err = c.Validate(t.Context(), loadTestRecord(t, "testdata/translation_record.json"))
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("got error %v for a record without schema version, want NotFound", err)
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	c := newTestClient(t, nil)
+
+	valid := loadTestRecord(t, "testdata/record_v0.6.0.json")
+	records := []*objectsv3.Record{valid, loadTestRecord(t, "testdata/translation_record.json"), valid}
+
+	var results []*Result
+
+	for result, err := range c.ValidateAll(t.Context(), slices.Values(records)) {
+		if err != nil {
+			t.Fatalf("failed to validate records: %v", err)
+		}
+
+		results = append(results, result)
+	}
+
+	if len(results) != len(records) {
+		t.Fatalf("got %d results, want %d", len(results), len(records))
+	}
+
+	for _, i := range []int{0, 2} {
+		if !results[i].Valid || results[i].Err != nil {
+			t.Errorf("got result %+v for record %d, want a valid record", results[i], i)
+		}
+	}
+
+	if status.Code(results[1].Err) != codes.NotFound {
+		t.Errorf("got error %v for a record without schema version, want NotFound", results[1].Err)
+	}
+}
+
+func TestValidateAllStopsWhenIterationStops(t *testing.T) {
+	c := newTestClient(t, nil)
+
+	valid := loadTestRecord(t, "testdata/record_v0.6.0.json")
+
+	// The records never end, so that the stream is only closed once iteration stops.
+	records := func(yield func(*objectsv3.Record) bool) {
+		for yield(valid) {
+		}
+	}
+
+	received := 0
+
+	for _, err := range c.ValidateAll(t.Context(), records) {
+		if err != nil {
+			t.Fatalf("failed to validate records: %v", err)
+		}
+
+		received++
+		if received == 3 {
+			break
+		}
+	}
+
+	if received != 3 {
+		t.Errorf("got %d results, want 3", received)
+	}
+}
+
+func TestValidateAllReturnsWhileRecordsBlock(t *testing.T) {
+	c := newTestClient(t, nil)
+
+	valid := loadTestRecord(t, "testdata/record_v0.6.0.json")
+
+	// The records block after the first one until the test ends.
+	unblock := make(chan struct{})
+	defer close(unblock)
+
+	records := func(yield func(*objectsv3.Record) bool) {
+		if yield(valid) {
+			<-unblock
+			yield(valid)
+		}
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for _, err := range c.ValidateAll(t.Context(), records) {
+			if err != nil {
+				t.Errorf("failed to validate records: %v", err)
+			}
+
+			break
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("ValidateAll did not return while the records were blocked")
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	c := newTestClient(t, nil)
+	record := loadTestRecord(t, "testdata/translation_record.json")
+
+	config, err := c.ToVSCode(t.Context(), record)
+	if err != nil {
+		t.Fatalf("failed to translate record to VS Code config: %v", err)
+	}
+
+	if got := config.Servers["github"].Command; got != "docker" {
+		t.Errorf("got github server command %q, want docker", got)
+	}
+
+	card, err := c.ToA2A(t.Context(), record)
+	if err != nil {
+		t.Fatalf("failed to translate record to A2A card: %v", err)
+	}
+
+	if card.Name != "example-agent" || len(card.Skills) != 1 || card.Skills[0].ID != "browser" {
+		t.Errorf("got A2A card %+v, want the card of the record", card)
+	}
+
+	// Errors of the service are returned with their status.
+	if _, err := c.ToA2A(t.Context(), loadTestRecord(t, "testdata/record_v0.6.0.json")); status.Code(err) != codes.NotFound {
+		t.Errorf("got error %v for a record without A2A extension, want NotFound", err)
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	var authorization []string
+
+	interceptor := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		authorization = metadata.ValueFromIncomingContext(ctx, "authorization")
+
+		return handler(ctx, req)
+	}
+
+	c := newTestClient(t, []grpc.ServerOption{grpc.UnaryInterceptor(interceptor)}, WithBearerToken("secret"))
+
+	if _, err := c.ToA2A(t.Context(), loadTestRecord(t, "testdata/translation_record.json")); err != nil {
+		t.Fatalf("failed to translate record: %v", err)
+	}
+
+	if !slices.Equal(authorization, []string{"Bearer secret"}) {
+		t.Errorf("got authorization %v, want the bearer token", authorization)
+	}
+}
+
+func TestNewRejectsSystemdSockets(t *testing.T) {
+	if _, err := New("systemd://grpc"); err == nil {
+		t.Error("expected systemd sockets to be rejected")
+	}
+}
@@ -0,0 +1,32 @@
+module github.com/agntcy/oasf-sdk/client
+
+go 1.24.4
+
+require (
+	buf.build/gen/go/agntcy/oasf-sdk/grpc/go v1.5.1-20250822074012-8eed55f5aabc.2
+	buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go v1.36.8-20250822074012-8eed55f5aabc.1
+	buf.build/gen/go/agntcy/oasf/protocolbuffers/go v1.36.8-20250730151615-132f40d05b24.1
+	github.com/agntcy/oasf-sdk/common v0.0.0-00010101000000-000000000000
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250603155806-513f23925822
+	google.golang.org/grpc v1.74.2
+	google.golang.org/protobuf v1.36.8
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/rogpeppe/go-internal v1.13.1 // indirect
+	go.opentelemetry.io/otel/sdk/metric v1.37.0 // indirect
+	golang.org/x/net v0.43.0 // indirect
+	golang.org/x/sys v0.35.0 // indirect
+	golang.org/x/text v0.28.0 // indirect
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
+)
+
+replace github.com/agntcy/oasf-sdk/common => ../common
+
+// Use the stubs generated from the local proto definitions until they are published to the BSR.
+replace (
+	buf.build/gen/go/agntcy/oasf-sdk/grpc/go => ../proto/gen/grpc/go
+	buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go => ../proto/gen/protocolbuffers/go
+)
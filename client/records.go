@@ -0,0 +1,85 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+// DecodeRecord decodes a JSON or YAML record. Records wrapped in a "record"
+// field, as in translation requests, are accepted too.
+func DecodeRecord(data []byte) (*objectsv3.Record, error) {
+	jsonData := data
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		var document any
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("invalid YAML document: %w", err)
+		}
+
+		var err error
+
+		jsonData, err = json.Marshal(document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert YAML to JSON: %w", err)
+		}
+	}
+
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(jsonData, &wrapper); err != nil {
+		return nil, fmt.Errorf("record must be an object: %w", err)
+	}
+
+	if inner, ok := wrapper["record"]; ok && len(wrapper) == 1 {
+		jsonData = inner
+	}
+
+	record := &objectsv3.Record{}
+
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err := unmarshaler.Unmarshal(jsonData, record); err != nil {
+		return nil, fmt.Errorf("invalid record: %w", err)
+	}
+
+	return record, nil
+}
+
+// LoadRecord reads a JSON or YAML record file.
+func LoadRecord(path string) (*objectsv3.Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read record: %w", err)
+	}
+
+	record, err := DecodeRecord(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode record %s: %w", path, err)
+	}
+
+	return record, nil
+}
+
+// LoadRecords reads JSON or YAML record files, eg. to validate them with ValidateAll.
+func LoadRecords(paths ...string) ([]*objectsv3.Record, error) {
+	records := make([]*objectsv3.Record, 0, len(paths))
+
+	for _, path := range paths {
+		record, err := LoadRecord(path)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
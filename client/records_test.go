@@ -0,0 +1,66 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDecodeRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "json", data: `{"name": "example", "schema_version": "v0.6.0"}`},
+		{name: "yaml", data: "name: example\nschema_version: v0.6.0\n"},
+		{name: "wrapped", data: `{"record": {"name": "example", "schema_version": "v0.6.0"}}`},
+		{name: "unknown fields", data: `{"name": "example", "schema_version": "v0.6.0", "unknown": true}`},
+		{name: "not an object", data: `- name: example`, wantErr: true},
+		{name: "invalid field", data: `{"name": 1}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := DecodeRecord([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to decode record: %v", err)
+			}
+
+			if record.GetName() != "example" || record.GetSchemaVersion() != "v0.6.0" {
+				t.Errorf("got record %v, want name and schema version", record)
+			}
+		})
+	}
+}
+
+func TestLoadRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "record.yaml")
+	if err := os.WriteFile(path, []byte("name: example\n"), 0o600); err != nil {
+		t.Fatalf("failed to write record: %v", err)
+	}
+
+	records, err := LoadRecords("testdata/record_v0.6.0.json", path)
+	if err != nil {
+		t.Fatalf("failed to load records: %v", err)
+	}
+
+	if len(records) != 2 || records[1].GetName() != "example" {
+		t.Errorf("got records %v, want the JSON and YAML records", records)
+	}
+
+	if _, err := LoadRecords("testdata/missing.json"); err == nil {
+		t.Error("expected a missing file to fail")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy decides how requests that failed because the server was unavailable or rate limited
+// the client are retried. Requests are retried after an exponential backoff with jitter, or after the
+// delay requested by the server when longer. Streams are not retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one.
+	// Requests are not retried when at most 1.
+	MaxAttempts int
+
+	// InitialBackoff is the backoff before the first retry, doubled on each retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum backoff between retries. Unlimited when zero.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of clients.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+func (p RetryPolicy) unaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+
+			delay, ok := p.retryDelay(err, attempt)
+			if !ok {
+				return err
+			}
+
+			// Requests that cannot be retried before their deadline fail right away.
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+				return err
+			}
+
+			timer := time.NewTimer(delay)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// retryDelay returns the delay before retrying a request that failed with err on the given attempt,
+// or false if it must not be retried.
+func (p RetryPolicy) retryDelay(err error, attempt int) (time.Duration, bool) {
+	if err == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	st := status.Convert(err)
+
+	switch st.Code() {
+	case codes.Unavailable:
+		return p.backoff(attempt), true
+	case codes.ResourceExhausted:
+		// Only the rate limit tells when to retry. Other limits, eg. on the message size,
+		// fail again on retry.
+		for _, detail := range st.Details() {
+			if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+				return max(p.backoff(attempt), retryInfo.GetRetryDelay().AsDuration()), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// backoff returns the backoff after the given attempt, between half and all of the exponential backoff.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for range attempt - 1 {
+		if backoff <= 0 || backoff > math.MaxInt64/2 || (p.MaxBackoff > 0 && backoff >= p.MaxBackoff) {
+			break
+		}
+
+		backoff *= 2
+	}
+
+	if p.MaxBackoff > 0 {
+		backoff = min(backoff, p.MaxBackoff)
+	}
+
+	if backoff <= 0 {
+		return 0
+	}
+
+	return backoff/2 + rand.N(backoff/2+1)
+}
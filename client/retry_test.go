@@ -0,0 +1,177 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// failingValidationServer fails its first requests with err, and then validates every record.
+type failingValidationServer struct {
+	validationv1grpc.UnimplementedValidationServiceServer
+
+	err      error
+	failures int
+	calls    int
+}
+
+func (s *failingValidationServer) ValidateRecord(context.Context, *validationv1.ValidateRecordRequest) (*validationv1.ValidateRecordResponse, error) {
+	s.calls++
+	if s.calls <= s.failures {
+		return nil, s.err
+	}
+
+	return &validationv1.ValidateRecordResponse{IsValid: true}, nil
+}
+
+func newFailingTestClient(t *testing.T, failing *failingValidationServer, policy RetryPolicy) *Client {
+	t.Helper()
+
+	server := grpc.NewServer()
+	validationv1grpc.RegisterValidationServiceServer(server, failing)
+
+	return serveTestClient(t, server, WithRetryPolicy(policy))
+}
+
+func rateLimitError(t *testing.T, delay time.Duration) error {
+	t.Helper()
+
+	st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		t.Fatalf("failed to add retry info: %v", err)
+	}
+
+	return st.Err()
+}
+
+func TestRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+	tests := []struct {
+		name      string
+		err       error
+		failures  int
+		wantCode  codes.Code
+		wantCalls int
+	}{
+		{
+			name:      "unavailable",
+			err:       status.Error(codes.Unavailable, "unavailable"),
+			failures:  2,
+			wantCode:  codes.OK,
+			wantCalls: 3,
+		},
+		{
+			name:      "too many failures",
+			err:       status.Error(codes.Unavailable, "unavailable"),
+			failures:  3,
+			wantCode:  codes.Unavailable,
+			wantCalls: 3,
+		},
+		{
+			name:      "rate limited",
+			err:       rateLimitError(t, 20*time.Millisecond),
+			failures:  1,
+			wantCode:  codes.OK,
+			wantCalls: 2,
+		},
+		{
+			name:      "message too large",
+			err:       status.Error(codes.ResourceExhausted, "message too large"),
+			failures:  1,
+			wantCode:  codes.ResourceExhausted,
+			wantCalls: 1,
+		},
+		{
+			name:      "invalid argument",
+			err:       status.Error(codes.InvalidArgument, "invalid record"),
+			failures:  1,
+			wantCode:  codes.InvalidArgument,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failing := &failingValidationServer{err: tt.err, failures: tt.failures}
+			c := newFailingTestClient(t, failing, policy)
+
+			_, err := c.Validate(t.Context(), &objectsv3.Record{})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("got error %v, want %s", err, tt.wantCode)
+			}
+
+			if failing.calls != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", failing.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryHonorsRetryDelay(t *testing.T) {
+	const delay = 100 * time.Millisecond
+
+	failing := &failingValidationServer{err: rateLimitError(t, delay), failures: 1}
+	c := newFailingTestClient(t, failing, RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+
+	start := time.Now()
+
+	if _, err := c.Validate(t.Context(), &objectsv3.Record{}); err != nil {
+		t.Fatalf("failed to validate record: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < delay {
+		t.Errorf("retried after %v, before the retry delay of the server", elapsed)
+	}
+
+	// Requests are not retried after their deadline.
+	failing = &failingValidationServer{err: rateLimitError(t, time.Minute), failures: 1}
+	c = newFailingTestClient(t, failing, RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	if _, err := c.Validate(ctx, &objectsv3.Record{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got error %v, want ResourceExhausted right away", err)
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		t.Error("expected the request to fail before its deadline")
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: 100 * time.Millisecond},
+		{attempt: 2, want: 200 * time.Millisecond},
+		{attempt: 4, want: 800 * time.Millisecond},
+		{attempt: 5, want: time.Second},
+		{attempt: 100, want: time.Second},
+	}
+
+	for _, tt := range tests {
+		for range 10 {
+			if got := policy.backoff(tt.attempt); got < tt.want/2 || got > tt.want {
+				t.Errorf("got backoff %v after attempt %d, want between %v and %v", got, tt.attempt, tt.want/2, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	translationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// VSCodeCopilotMCPConfig is the MCP configuration of VS Code Copilot translated from a record.
+type VSCodeCopilotMCPConfig struct {
+	Servers map[string]MCPServer `json:"servers"`
+	Inputs  []MCPInput           `json:"inputs"`
+}
+
+// MCPServer is an MCP server of a VS Code Copilot MCP configuration.
+type MCPServer struct {
+	Command string            `json:"command"`
+	Args    []string          `json:"args"`
+	Env     map[string]string `json:"env"`
+}
+
+// MCPInput is an input prompted by VS Code Copilot, eg. a secret referenced by the environment of a server.
+type MCPInput struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Password    bool   `json:"password"`
+	Description string `json:"description"`
+}
+
+// A2ACard is the A2A card translated from a record.
+type A2ACard struct {
+	Name               string          `json:"name"`
+	Description        string          `json:"description"`
+	URL                string          `json:"url"`
+	Capabilities       map[string]bool `json:"capabilities"`
+	DefaultInputModes  []string        `json:"defaultInputModes"`
+	DefaultOutputModes []string        `json:"defaultOutputModes"`
+	Skills             []A2ASkill      `json:"skills"`
+}
+
+// A2ASkill is a skill of an A2A card.
+type A2ASkill struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ToVSCode translates a record into the MCP configuration of VS Code Copilot.
+func (c *Client) ToVSCode(ctx context.Context, record *objectsv3.Record) (*VSCodeCopilotMCPConfig, error) {
+	resp, err := c.translation.RecordToVSCodeCopilot(ctx, &translationv1.RecordToVSCodeCopilotRequest{Record: record})
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate record to VS Code Copilot MCP config: %w", err)
+	}
+
+	config := &VSCodeCopilotMCPConfig{}
+	if err := decodeField(resp.GetData(), "mcpConfig", config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// ToA2A translates a record into an A2A card.
+func (c *Client) ToA2A(ctx context.Context, record *objectsv3.Record) (*A2ACard, error) {
+	resp, err := c.translation.RecordToA2A(ctx, &translationv1.RecordToA2ARequest{Record: record})
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate record to A2A card: %w", err)
+	}
+
+	card := &A2ACard{}
+	if err := decodeField(resp.GetData(), "a2aCard", card); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// decodeField decodes the field of a translation response that holds the translated document into out.
+func decodeField(data *structpb.Struct, key string, out any) error {
+	field, ok := data.GetFields()[key]
+	if !ok {
+		return fmt.Errorf("translation response has no %q field", key)
+	}
+
+	fieldData, err := protojson.Marshal(field)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+
+	if err := json.Unmarshal(fieldData, out); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+
+	return nil
+}
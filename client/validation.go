@@ -0,0 +1,165 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Result is the validation result of a record.
+type Result struct {
+	// Valid is true if the record conforms to its schema.
+	Valid bool
+
+	// Errors lists the schema violations of an invalid record.
+	Errors []string
+
+	// Warnings lists the issues that do not make the record invalid, eg. a deprecated schema version.
+	Warnings []string
+
+	// SchemaVersion is the schema version the record was validated against, unless validated
+	// against a schema URL.
+	SchemaVersion string
+
+	// Err is set by ValidateAll when the record could not be validated, eg. because its schema
+	// version is not supported.
+	Err error
+}
+
+// ValidateOption configures how records are validated.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	schemaURL           string
+	detectSchemaVersion bool
+}
+
+// WithSchemaURL validates records against the schema at url rather than the schema of their version.
+func WithSchemaURL(url string) ValidateOption {
+	return func(o *validateOptions) {
+		o.schemaURL = url
+	}
+}
+
+// WithSchemaVersionDetection validates records without a schema version against the schema version
+// they conform to, rather than rejecting them.
+func WithSchemaVersionDetection() ValidateOption {
+	return func(o *validateOptions) {
+		o.detectSchemaVersion = true
+	}
+}
+
+func newValidateOptions(opts []ValidateOption) validateOptions {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// Validate validates a record.
+func (c *Client) Validate(ctx context.Context, record *objectsv3.Record, opts ...ValidateOption) (*Result, error) {
+	o := newValidateOptions(opts)
+
+	resp, err := c.validation.ValidateRecord(ctx, &validationv1.ValidateRecordRequest{
+		Record:              record,
+		SchemaUrl:           o.schemaURL,
+		DetectSchemaVersion: o.detectSchemaVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate record: %w", err)
+	}
+
+	return &Result{
+		Valid:         resp.GetIsValid(),
+		Errors:        resp.GetErrors(),
+		Warnings:      resp.GetWarnings(),
+		SchemaVersion: resp.GetSchemaVersion(),
+	}, nil
+}
+
+// ValidateAll validates records over a single stream, and yields their results in the order of the records.
+// Records that cannot be validated have a result with Err set. Iteration stops at the first error of the
+// stream itself, which is yielded with a nil result. Records are read while results are received. Once iteration
+// stops, ValidateAll returns without waiting for the records, which are no longer read after the next record.
+func (c *Client) ValidateAll(ctx context.Context, records iter.Seq[*objectsv3.Record], opts ...ValidateOption) iter.Seq2[*Result, error] {
+	o := newValidateOptions(opts)
+
+	return func(yield func(*Result, error) bool) {
+		// The records are not waited for once iteration stops, since their iterator may be blocked
+		// until the next record.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := c.validation.ValidateRecordStream(ctx)
+		if err != nil {
+			yield(nil, fmt.Errorf("failed to open validation stream: %w", err))
+
+			return
+		}
+
+		// Records are sent until the stream fails, or is canceled once iteration stops.
+		go func() {
+			for record := range records {
+				if ctx.Err() != nil {
+					return
+				}
+
+				err := stream.Send(&validationv1.ValidateRecordStreamRequest{
+					Record:              record,
+					SchemaUrl:           o.schemaURL,
+					DetectSchemaVersion: o.detectSchemaVersion,
+				})
+				if err != nil {
+					// The error of the stream is returned by Recv.
+					return
+				}
+			}
+
+			_ = stream.CloseSend()
+		}()
+
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(nil, fmt.Errorf("failed to receive validation result: %w", err))
+
+				return
+			}
+
+			if !yield(streamResult(resp), nil) {
+				return
+			}
+		}
+	}
+}
+
+func streamResult(resp *validationv1.ValidateRecordStreamResponse) *Result {
+	result := &Result{
+		Valid:         resp.GetIsValid(),
+		Errors:        resp.GetErrors(),
+		Warnings:      resp.GetWarnings(),
+		SchemaVersion: resp.GetSchemaVersion(),
+	}
+
+	if resp.HasError() {
+		result.Err = status.Error(codes.Code(resp.GetError().GetCode()), resp.GetError().GetMessage())
+	}
+
+	return result
+}
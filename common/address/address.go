@@ -0,0 +1,46 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package address handles the listen addresses of the servers, which clients of the servers use too.
+// It only depends on the standard library, so that clients do not depend on the servers.
+package address
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	// UnixScheme prefixes the path of a unix domain socket listen address, eg. "unix:///run/oasf/validation.sock".
+	UnixScheme = "unix://"
+
+	// SystemdScheme prefixes the name of a listener inherited through systemd socket activation,
+	// eg. "systemd://grpc" for the socket with FileDescriptorName=grpc. The name can be omitted
+	// when a single socket is inherited.
+	SystemdScheme = "systemd://"
+)
+
+// ClientTarget returns the gRPC client target to reach a server listening on a listen address
+// from the same host: unix socket addresses are dialed as unix sockets, and unspecified hosts,
+// eg. "0.0.0.0", as localhost. Addresses that are not listen addresses, eg. other gRPC targets,
+// are returned as is.
+func ClientTarget(address string) (string, error) {
+	switch {
+	case strings.HasPrefix(address, UnixScheme):
+		return "unix:" + strings.TrimPrefix(address, UnixScheme), nil
+	case strings.HasPrefix(address, SystemdScheme):
+		return "", fmt.Errorf("the address of systemd socket %s is not known to the server", address)
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address, nil
+	}
+
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,36 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package address
+
+import "testing"
+
+func TestClientTarget(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{address: "0.0.0.0:31235", want: "localhost:31235"},
+		{address: ":31235", want: "localhost:31235"},
+		{address: "[::]:31235", want: "localhost:31235"},
+		{address: "10.0.0.1:31235", want: "10.0.0.1:31235"},
+		{address: "unix:///run/oasf/validation.sock", want: "unix:/run/oasf/validation.sock"},
+		{address: "unix://validation.sock", want: "unix:validation.sock"},
+		{address: "dns:///validation.example.com:443", want: "dns:///validation.example.com:443"},
+	}
+
+	for _, tt := range tests {
+		got, err := ClientTarget(tt.address)
+		if err != nil {
+			t.Errorf("ClientTarget(%q): %v", tt.address, err)
+		}
+
+		if got != tt.want {
+			t.Errorf("ClientTarget(%q) = %q, want %q", tt.address, got, tt.want)
+		}
+	}
+
+	if _, err := ClientTarget("systemd://grpc"); err == nil {
+		t.Error("expected systemd sockets to have no client target")
+	}
+}
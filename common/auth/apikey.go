@@ -0,0 +1,74 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// APIKeys authenticates static API keys.
+type APIKeys struct {
+	// principals maps the hash of each API key to its principal, so that keys are not
+	// compared byte by byte.
+	principals map[[sha256.Size]byte]string
+
+	// names holds the principals of the API keys.
+	names map[string]struct{}
+}
+
+// NewAPIKeys creates an authenticator of the API keys of each principal.
+func NewAPIKeys(keys map[string]string) (*APIKeys, error) {
+	principals := make(map[[sha256.Size]byte]string, len(keys))
+	names := make(map[string]struct{}, len(keys))
+
+	for principal, key := range keys {
+		if principal == "" || key == "" {
+			return nil, fmt.Errorf("API key of principal %q is empty", principal)
+		}
+
+		hash := sha256.Sum256([]byte(key))
+		if other, ok := principals[hash]; ok {
+			return nil, fmt.Errorf("principals %q and %q have the same API key", other, principal)
+		}
+
+		principals[hash] = principal
+		names[principal] = struct{}{}
+	}
+
+	return &APIKeys{principals: principals, names: names}, nil
+}
+
+// LoadAPIKeys loads the API keys of a YAML file mapping principal names to their API key.
+func LoadAPIKeys(path string) (*APIKeys, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API keys file: %w", err)
+	}
+
+	var keys map[string]string
+	if err := yaml.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse API keys file: %w", err)
+	}
+
+	apiKeys, err := NewAPIKeys(keys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API keys file: %w", err)
+	}
+
+	return apiKeys, nil
+}
+
+func (a *APIKeys) Authenticate(_ context.Context, token string) (string, error) {
+	principal, ok := a.principals[sha256.Sum256([]byte(token))]
+	if !ok {
+		return "", ErrUnknownToken
+	}
+
+	return principal, nil
+}
@@ -0,0 +1,130 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package auth authenticates the callers of the SDK servers and authorizes their requests.
+//
+// Callers send a bearer token in the authorization metadata, which is either a static API key
+// or a JWT signed by one of the keys of a local JWKS file. The authenticated principal is the
+// name of the API key, or the subject of the JWT.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Config holds the authentication and authorization options of a server.
+// Authentication is disabled unless API keys or a JWKS file are set.
+type Config struct {
+	// APIKeysFile is a YAML file mapping principal names to their API key.
+	APIKeysFile string `json:"api_keys_file,omitempty" mapstructure:"api_keys_file"`
+
+	// JWKSFile is a JSON Web Key Set file holding the public keys JWTs are verified against.
+	JWKSFile string `json:"jwks_file,omitempty" mapstructure:"jwks_file"`
+
+	// JWTIssuer is the issuer JWTs must be issued by, if set.
+	JWTIssuer string `json:"jwt_issuer,omitempty" mapstructure:"jwt_issuer"`
+
+	// JWTAudience is the audience JWTs must be issued for, if set.
+	JWTAudience string `json:"jwt_audience,omitempty" mapstructure:"jwt_audience"`
+
+	// RulesFile is a YAML file of the authorization rules. Every authenticated principal is
+	// allowed everything when empty.
+	RulesFile string `json:"rules_file,omitempty" mapstructure:"rules_file"`
+}
+
+// Enabled reports whether callers must authenticate.
+func (c Config) Enabled() bool {
+	return c.APIKeysFile != "" || c.JWKSFile != ""
+}
+
+// ErrUnknownToken is returned by authenticators for tokens they do not know,
+// so that the token is tried against the next authenticator.
+var ErrUnknownToken = errors.New("unknown token")
+
+// Authenticator authenticates bearer tokens.
+type Authenticator interface {
+	// Authenticate returns the principal the token belongs to.
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// Authenticators authenticates tokens with the first authenticator that knows them.
+type Authenticators []Authenticator
+
+func (a Authenticators) Authenticate(ctx context.Context, token string) (string, error) {
+	for _, authenticator := range a {
+		principal, err := authenticator.Authenticate(ctx, token)
+		if errors.Is(err, ErrUnknownToken) {
+			continue
+		}
+
+		return principal, err
+	}
+
+	return "", ErrUnknownToken
+}
+
+// NewAuthenticator creates the authenticators enabled in the configuration.
+func NewAuthenticator(cfg Config) (Authenticator, error) {
+	var authenticators Authenticators
+
+	if cfg.APIKeysFile != "" {
+		apiKeys, err := LoadAPIKeys(cfg.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+
+		authenticators = append(authenticators, apiKeys)
+	}
+
+	if cfg.JWKSFile != "" {
+		jwks, err := NewJWTAuthenticator(cfg.JWKSFile, cfg.JWTIssuer, cfg.JWTAudience)
+		if err != nil {
+			return nil, err
+		}
+
+		authenticators = append(authenticators, jwks)
+	}
+
+	if len(authenticators) == 0 {
+		return nil, fmt.Errorf("no authentication method configured")
+	}
+
+	return authenticators, nil
+}
+
+type authInfoKey struct{}
+
+// authInfo is the authentication of a request.
+type authInfo struct {
+	principal string
+	label     string
+	policy    *Policy
+}
+
+// newContext returns a context of a request authenticated as principal, authorized with policy.
+// The principal is counted in metrics as label.
+func newContext(ctx context.Context, principal, label string, policy *Policy) context.Context {
+	return context.WithValue(ctx, authInfoKey{}, authInfo{principal: principal, label: label, policy: policy})
+}
+
+// Principal returns the principal a request is authenticated as, or an empty string
+// when authentication is disabled.
+func Principal(ctx context.Context) string {
+	info, _ := ctx.Value(authInfoKey{}).(authInfo)
+
+	return info.principal
+}
+
+// Authorize checks that the principal of a request is allowed permission, eg. a method name or a
+// feature such as "validation.schema_url". It returns a PermissionDenied status error otherwise.
+// Every request is allowed when authentication is disabled.
+func Authorize(ctx context.Context, permission string) error {
+	info, ok := ctx.Value(authInfoKey{}).(authInfo)
+	if !ok {
+		return nil
+	}
+
+	return info.policy.authorize(info.principal, info.label, permission)
+}
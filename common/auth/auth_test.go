@@ -0,0 +1,290 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com"
+	testAudience = "oasf-sdk"
+	testMethod   = "/validation.v1.ValidationService/ValidateRecord"
+)
+
+// testSigner signs JWTs with a key of a JWKS file.
+type testSigner struct {
+	signer jose.Signer
+}
+
+func newTestSigner(t *testing.T, jwksPath, keyID string) *testSigner {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key:       &key.PublicKey,
+		KeyID:     keyID,
+		Algorithm: string(jose.ES256),
+		Use:       "sig",
+	}}}
+
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatalf("failed to marshal JWKS: %v", err)
+	}
+
+	writeFile(t, jwksPath, string(data))
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), keyID),
+	)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+
+	return &testSigner{signer: signer}
+}
+
+func (s *testSigner) sign(t *testing.T, claims jwt.Claims) string {
+	t.Helper()
+
+	token, err := jwt.Signed(s.signer).Claims(claims).Serialize()
+	if err != nil {
+		t.Fatalf("failed to sign JWT: %v", err)
+	}
+
+	return token
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+func validClaims(subject string) jwt.Claims {
+	return jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  subject,
+		Audience: jwt.Audience{testAudience},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+// call calls a unary method through the interceptor with the given bearer token, and returns
+// the context the handler is called with.
+func call(interceptor *Interceptor, method, token string) (context.Context, error) {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationHeader, "Bearer "+token))
+	}
+
+	var handlerCtx context.Context
+
+	_, err := interceptor.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, _ any) (any, error) {
+			handlerCtx = ctx
+
+			return struct{}{}, nil
+		})
+
+	return handlerCtx, err
+}
+
+func TestInterceptor(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := Config{
+		APIKeysFile: filepath.Join(dir, "api-keys.yaml"),
+		JWKSFile:    filepath.Join(dir, "jwks.json"),
+		JWTIssuer:   testIssuer,
+		JWTAudience: testAudience,
+		RulesFile:   filepath.Join(dir, "rules.yaml"),
+	}
+
+	writeFile(t, cfg.APIKeysFile, "ci: ci-key\nreader: reader-key\n")
+	writeFile(t, cfg.RulesFile, `rules:
+  - permission: validation.schema_url
+    principals: [ci, alice]
+  - permission: /translation.v1.TranslationService/*
+    principals: ["*"]
+  - permission: /admin.v1.AdminService/*
+    principals: [ci]
+`)
+
+	signer := newTestSigner(t, cfg.JWKSFile, "key-1")
+
+	interceptor, err := NewInterceptor(cfg)
+	if err != nil {
+		t.Fatalf("failed to create interceptor: %v", err)
+	}
+
+	expired := validClaims("alice")
+	expired.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+	wrongAudience := validClaims("alice")
+	wrongAudience.Audience = jwt.Audience{"other"}
+
+	otherSigner := newTestSigner(t, filepath.Join(dir, "other-jwks.json"), "key-1")
+
+	tests := []struct {
+		name      string
+		method    string
+		token     string
+		code      codes.Code
+		principal string
+		schemaURL bool
+	}{
+		{name: "API key", method: testMethod, token: "ci-key", principal: "ci", schemaURL: true},
+		{name: "API key without schema URL permission", method: testMethod, token: "reader-key", principal: "reader"},
+		{name: "JWT", method: testMethod, token: signer.sign(t, validClaims("alice")), principal: "alice", schemaURL: true},
+		{name: "JWT without schema URL permission", method: testMethod, token: signer.sign(t, validClaims("bob")), principal: "bob"},
+		{name: "wildcard rule", method: "/translation.v1.TranslationService/RecordToA2A", token: "reader-key", principal: "reader"},
+		{name: "method denied", method: "/admin.v1.AdminService/Reload", token: "reader-key", code: codes.PermissionDenied},
+		{name: "method allowed", method: "/admin.v1.AdminService/Reload", token: "ci-key", principal: "ci", schemaURL: true},
+		{name: "health is public", method: "/grpc.health.v1.Health/Check", schemaURL: true},
+		{name: "missing token", method: testMethod, code: codes.Unauthenticated},
+		{name: "unknown API key", method: testMethod, token: "unknown-key", code: codes.Unauthenticated},
+		{name: "expired JWT", method: testMethod, token: signer.sign(t, expired), code: codes.Unauthenticated},
+		{name: "JWT of another audience", method: testMethod, token: signer.sign(t, wrongAudience), code: codes.Unauthenticated},
+		{name: "JWT signed by another key", method: testMethod, token: otherSigner.sign(t, validClaims("alice")), code: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := call(interceptor, tt.method, tt.token)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("got code %v, want %v: %v", got, tt.code, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got := Principal(ctx); got != tt.principal {
+				t.Errorf("got principal %q, want %q", got, tt.principal)
+			}
+
+			err = Authorize(ctx, "validation.schema_url")
+			if allowed := err == nil; allowed != tt.schemaURL {
+				t.Errorf("got schema URL allowed %v, want %v: %v", allowed, tt.schemaURL, err)
+			}
+
+			if err != nil && status.Code(err) != codes.PermissionDenied {
+				t.Errorf("got code %v, want %v", status.Code(err), codes.PermissionDenied)
+			}
+		})
+	}
+}
+
+func TestInterceptorPrincipalLabels(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := Config{
+		APIKeysFile: filepath.Join(dir, "api-keys.yaml"),
+		JWKSFile:    filepath.Join(dir, "jwks.json"),
+		JWTIssuer:   testIssuer,
+		JWTAudience: testAudience,
+	}
+
+	writeFile(t, cfg.APIKeysFile, "ci: ci-key\n")
+
+	signer := newTestSigner(t, cfg.JWKSFile, "key-1")
+
+	interceptor, err := NewInterceptor(cfg)
+	if err != nil {
+		t.Fatalf("failed to create interceptor: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		label string
+	}{
+		{name: "API key", token: "ci-key", label: "ci"},
+		{name: "JWT", token: signer.sign(t, validClaims("alice")), label: jwtPrincipalLabel},
+		{name: "JWT of an API key principal name", token: signer.sign(t, validClaims("ci")), label: "ci"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := authRequests.WithLabelValues(testMethod, tt.label, resultAllowed)
+			before := testutil.ToFloat64(counter)
+
+			if _, err := call(interceptor, testMethod, tt.token); err != nil {
+				t.Fatalf("failed to authenticate: %v", err)
+			}
+
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("got %v requests labeled %q, want 1", got, tt.label)
+			}
+		})
+	}
+
+	if got := testutil.ToFloat64(authRequests.WithLabelValues(testMethod, "alice", resultAllowed)); got != 0 {
+		t.Errorf("got %v requests labeled with the JWT subject, want 0", got)
+	}
+}
+
+func TestJWTAuthenticatorReloadsKeys(t *testing.T) {
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	newTestSigner(t, jwksPath, "key-1")
+
+	authenticator, err := NewJWTAuthenticator(jwksPath, "", "")
+	if err != nil {
+		t.Fatalf("failed to create authenticator: %v", err)
+	}
+
+	rotated := newTestSigner(t, jwksPath, "key-2")
+	token := rotated.sign(t, validClaims("alice"))
+
+	// The file is not reloaded more than once per interval.
+	if _, err := authenticator.Authenticate(context.Background(), token); err == nil {
+		t.Fatal("authenticated a JWT signed by a key rotated within the reload interval")
+	}
+
+	authenticator.loadedAt = time.Now().Add(-jwksReloadInterval)
+
+	principal, err := authenticator.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatalf("failed to authenticate a JWT signed by a rotated key: %v", err)
+	}
+
+	if principal != "alice" {
+		t.Errorf("got principal %q, want %q", principal, "alice")
+	}
+}
+
+func TestAuthorizeWithoutAuthentication(t *testing.T) {
+	if err := Authorize(context.Background(), "validation.schema_url"); err != nil {
+		t.Errorf("got error %v when authentication is disabled", err)
+	}
+
+	if got := Principal(context.Background()); got != "" {
+		t.Errorf("got principal %q when authentication is disabled", got)
+	}
+}
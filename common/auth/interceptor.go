@@ -0,0 +1,153 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerScheme        = "bearer "
+
+	// publicMethodPrefix is the prefix of the methods that do not require authentication,
+	// so that health probes do not need credentials.
+	publicMethodPrefix = "/grpc.health.v1.Health/"
+)
+
+var errMissingToken = errors.New("missing bearer token")
+
+// Interceptor authenticates the requests of a gRPC server and authorizes their method.
+type Interceptor struct {
+	authenticator Authenticator
+	policy        *Policy
+}
+
+// NewInterceptor creates an interceptor of the authenticators and rules of the configuration.
+func NewInterceptor(cfg Config) (*Interceptor, error) {
+	authenticator, err := NewAuthenticator(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy *Policy
+	if cfg.RulesFile != "" {
+		policy, err = LoadPolicy(cfg.RulesFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Interceptor{
+		authenticator: authenticator,
+		policy:        policy,
+	}, nil
+}
+
+// UnaryServerInterceptor authenticates unary requests.
+func (i *Interceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates streaming requests.
+func (i *Interceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticate returns the context of a request authenticated by its bearer token,
+// or an Unauthenticated or PermissionDenied status error.
+func (i *Interceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if strings.HasPrefix(method, publicMethodPrefix) {
+		return ctx, nil
+	}
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, reject(ctx, method, errMissingToken)
+	}
+
+	principal, err := i.authenticator.Authenticate(ctx, token)
+	if err != nil {
+		return nil, reject(ctx, method, err)
+	}
+
+	label := principalLabel(i.authenticator, principal)
+
+	if err := i.policy.authorize(principal, label, method); err != nil {
+		authRequests.WithLabelValues(method, label, resultPermissionDenied).Inc()
+		slog.Warn("Denied request", "method", method, "principal", principal, "peer", peerAddress(ctx))
+
+		return nil, err
+	}
+
+	authRequests.WithLabelValues(method, label, resultAllowed).Inc()
+
+	return newContext(ctx, principal, label, i.policy), nil
+}
+
+// reject returns the Unauthenticated status error of a request. The reason is only logged,
+// so that callers cannot probe which tokens are known.
+func reject(ctx context.Context, method string, reason error) error {
+	authRequests.WithLabelValues(method, "", resultUnauthenticated).Inc()
+	slog.Warn("Rejected unauthenticated request", "method", method, "peer", peerAddress(ctx), "reason", reason)
+
+	return status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+}
+
+// bearerToken returns the bearer token of the authorization metadata of a request.
+func bearerToken(ctx context.Context) (string, bool) {
+	values := metadata.ValueFromIncomingContext(ctx, authorizationHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	value := values[0]
+	if len(value) <= len(bearerScheme) || !strings.EqualFold(value[:len(bearerScheme)], bearerScheme) {
+		return "", false
+	}
+
+	return strings.TrimSpace(value[len(bearerScheme):]), true
+}
+
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return p.Addr.String()
+}
+
+// authenticatedStream is a server stream with the context of its authenticated request.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,159 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// jwksReloadInterval is the minimum interval between reloads of the JWKS file,
+// which is reloaded when a JWT is signed by an unknown key.
+const jwksReloadInterval = 10 * time.Second
+
+// signatureAlgorithms are the asymmetric algorithms JWTs may be signed with.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTAuthenticator authenticates JWTs signed by the keys of a JWKS file.
+// The principal of a JWT is its subject.
+type JWTAuthenticator struct {
+	path     string
+	issuer   string
+	audience string
+
+	mu       sync.Mutex
+	keys     *jose.JSONWebKeySet
+	loadedAt time.Time
+}
+
+// NewJWTAuthenticator creates an authenticator of the JWTs signed by the keys of the JWKS file at path.
+// The issuer and audience of JWTs are verified when set.
+func NewJWTAuthenticator(path, issuer, audience string) (*JWTAuthenticator, error) {
+	a := &JWTAuthenticator{
+		path:     path,
+		issuer:   issuer,
+		audience: audience,
+	}
+
+	keys, err := a.load()
+	if err != nil {
+		return nil, err
+	}
+
+	a.keys = keys
+	a.loadedAt = time.Now()
+
+	return a, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(_ context.Context, token string) (string, error) {
+	parsed, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		// Not a JWT, eg. an API key.
+		return "", ErrUnknownToken
+	}
+
+	keys := a.verificationKeys(parsed.Headers[0].KeyID)
+	if len(keys) == 0 {
+		return "", fmt.Errorf("JWT is signed by an unknown key %q", parsed.Headers[0].KeyID)
+	}
+
+	var claims jwt.Claims
+	for _, key := range keys {
+		err = parsed.Claims(key.Key, &claims)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid JWT signature: %w", err)
+	}
+
+	if claims.Expiry == nil {
+		return "", errors.New("JWT has no expiry")
+	}
+
+	expected := jwt.Expected{Issuer: a.issuer}
+	if a.audience != "" {
+		expected.AnyAudience = jwt.Audience{a.audience}
+	}
+
+	if err := claims.Validate(expected); err != nil {
+		return "", fmt.Errorf("invalid JWT claims: %w", err)
+	}
+
+	if claims.Subject == "" {
+		return "", errors.New("JWT has no subject")
+	}
+
+	return claims.Subject, nil
+}
+
+// verificationKeys returns the public keys that may have signed a JWT with the given key ID,
+// or every key when the JWT has no key ID. The JWKS file is reloaded once in a while when
+// no key matches, so that rotated keys are picked up without a restart.
+func (a *JWTAuthenticator) verificationKeys(keyID string) []jose.JSONWebKey {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	keys := a.matchingKeys(keyID)
+	if len(keys) > 0 || time.Since(a.loadedAt) < jwksReloadInterval {
+		return keys
+	}
+
+	a.loadedAt = time.Now()
+
+	reloaded, err := a.load()
+	if err != nil {
+		slog.Error("Failed to reload JWKS file, keeping the previous keys", "path", a.path, "error", err)
+
+		return nil
+	}
+
+	a.keys = reloaded
+
+	return a.matchingKeys(keyID)
+}
+
+func (a *JWTAuthenticator) matchingKeys(keyID string) []jose.JSONWebKey {
+	if keyID == "" {
+		return a.keys.Keys
+	}
+
+	return a.keys.Key(keyID)
+}
+
+func (a *JWTAuthenticator) load() (*jose.JSONWebKeySet, error) {
+	data, err := os.ReadFile(a.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file: %w", err)
+	}
+
+	for _, key := range keys.Keys {
+		if !key.IsPublic() {
+			return nil, fmt.Errorf("JWKS file holds the private key %q, only public keys are allowed", key.KeyID)
+		}
+	}
+
+	return &keys, nil
+}
@@ -0,0 +1,54 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Results of authenticating and authorizing a request.
+const (
+	resultAllowed          = "allowed"
+	resultUnauthenticated  = "unauthenticated"
+	resultPermissionDenied = "permission_denied"
+)
+
+// jwtPrincipalLabel is the principal label of the requests of JWT principals, whose subjects are unbounded.
+const jwtPrincipalLabel = "jwt"
+
+var (
+	authRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "oasf",
+		Subsystem: "auth",
+		Name:      "requests_total",
+		Help:      "Number of requests authenticated, by method, principal (jwt for JWTs) and result: allowed, unauthenticated or permission_denied.",
+	}, []string{"grpc_method", "principal", "result"})
+
+	permissionDenials = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "oasf",
+		Subsystem: "auth",
+		Name:      "permission_denials_total",
+		Help:      "Number of permissions denied, eg. methods or features of a service, by permission and principal (jwt for JWTs).",
+	}, []string{"permission", "principal"})
+)
+
+// principalLabel returns the principal label of the metrics of requests that authenticator authenticated
+// as principal. API key principals are labeled with their name, as they are a fixed set, and others with jwt.
+func principalLabel(authenticator Authenticator, principal string) string {
+	switch a := authenticator.(type) {
+	case Authenticators:
+		for _, authenticator := range a {
+			if label := principalLabel(authenticator, principal); label != jwtPrincipalLabel {
+				return label
+			}
+		}
+	case *APIKeys:
+		if _, ok := a.names[principal]; ok {
+			return principal
+		}
+	}
+
+	return jwtPrincipalLabel
+}
@@ -0,0 +1,100 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// AnyPrincipal allows every authenticated principal in a rule.
+const AnyPrincipal = "*"
+
+// Rule restricts a permission to a set of principals.
+type Rule struct {
+	// Permission is a full gRPC method name, eg. "/validation.v1.ValidationService/ValidateRecord",
+	// or a feature of a service, eg. "validation.schema_url". A trailing "*" matches any suffix,
+	// eg. "/validation.v1.ValidationService/*".
+	Permission string `json:"permission" yaml:"permission"`
+
+	// Principals are allowed the permission, or "*" for every authenticated principal.
+	Principals []string `json:"principals" yaml:"principals"`
+}
+
+func (r Rule) matches(permission string) bool {
+	if prefix, ok := strings.CutSuffix(r.Permission, "*"); ok {
+		return strings.HasPrefix(permission, prefix)
+	}
+
+	return r.Permission == permission
+}
+
+// Policy authorizes principals according to a set of rules.
+//
+// A permission that no rule matches is allowed to every authenticated principal.
+// Otherwise, it is allowed to the principals of any matching rule.
+type Policy struct {
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+// LoadPolicy loads the rules of a YAML file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file: %w", err)
+	}
+
+	policy := &Policy{}
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file: %w", err)
+	}
+
+	for i, rule := range policy.Rules {
+		if rule.Permission == "" {
+			return nil, fmt.Errorf("rule %d of rules file has no permission", i)
+		}
+	}
+
+	return policy, nil
+}
+
+// Allowed reports whether principal is allowed permission.
+func (p *Policy) Allowed(principal, permission string) bool {
+	if p == nil {
+		return true
+	}
+
+	matched := false
+
+	for _, rule := range p.Rules {
+		if !rule.matches(permission) {
+			continue
+		}
+
+		if slices.Contains(rule.Principals, AnyPrincipal) || slices.Contains(rule.Principals, principal) {
+			return true
+		}
+
+		matched = true
+	}
+
+	return !matched
+}
+
+// authorize checks that principal is allowed permission, counting denials as label.
+func (p *Policy) authorize(principal, label, permission string) error {
+	if !p.Allowed(principal, permission) {
+		permissionDenials.WithLabelValues(permission, label).Inc()
+
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed %s", principal, permission)
+	}
+
+	return nil
+}
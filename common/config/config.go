@@ -0,0 +1,195 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package config holds the configuration helpers shared by the SDK servers.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// FileFlag is the flag of the YAML configuration file.
+const FileFlag = "config"
+
+// Option is a configuration option, with its default value and the description of its flag.
+type Option struct {
+	// Key is the key of the option, eg. "tls.cert_file".
+	Key string
+
+	// Default is the default value of the option, which also sets the type of its flag.
+	// One of: string, bool, int, float64, time.Duration, []string.
+	Default any
+
+	// Usage describes the option in the help of its flag.
+	Usage string
+}
+
+// NewViper creates a viper instance that reads configuration keys from environment
+// variables with the given prefix, eg. "stream_workers" from VALIDATION_SERVER_STREAM_WORKERS.
+// Nested keys are separated by dots, eg. "validation.stream_workers" from OASF_SERVER_VALIDATION_STREAM_WORKERS.
+func NewViper(envPrefix string) *viper.Viper {
+	v := viper.NewWithOptions(
+		viper.KeyDelimiter("."),
+		viper.EnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_")),
+	)
+
+	v.SetEnvPrefix(envPrefix)
+	v.AllowEmptyEnv(true)
+	v.AutomaticEnv()
+
+	return v
+}
+
+// Bind binds a configuration key to its environment variable and sets its default value.
+func Bind(v *viper.Viper, key string, value any) {
+	_ = v.BindEnv(key)
+	v.SetDefault(key, value)
+}
+
+// Key returns the key of a configuration option nested under parent, or the option
+// name itself when parent is empty.
+func Key(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
+
+// FlagName returns the name of the flag of a configuration key, eg. "tls-cert-file" for "tls.cert_file".
+func FlagName(key string) string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(key)
+}
+
+// AddFlags adds the configuration file flag and a flag per option to flags.
+func AddFlags(flags *pflag.FlagSet, options []Option) {
+	flags.String(FileFlag, "", "path of a YAML configuration file")
+
+	for _, option := range options {
+		name := FlagName(option.Key)
+
+		switch value := option.Default.(type) {
+		case string:
+			flags.String(name, value, option.Usage)
+		case bool:
+			flags.Bool(name, value, option.Usage)
+		case int:
+			flags.Int(name, value, option.Usage)
+		case float64:
+			flags.Float64(name, value, option.Usage)
+		case time.Duration:
+			flags.Duration(name, value, option.Usage)
+		case []string:
+			flags.StringSlice(name, value, option.Usage)
+		default:
+			panic(fmt.Sprintf("unsupported type %T of configuration option %s", value, option.Key))
+		}
+	}
+}
+
+// Load decodes the configuration options into out. Options are read from flags, environment
+// variables, the configuration file set by the FileFlag flag and their default value, in that
+// order of precedence. Flags are only read when not nil.
+func Load(v *viper.Viper, options []Option, flags *pflag.FlagSet, out any) error {
+	for _, option := range options {
+		Bind(v, option.Key, option.Default)
+	}
+
+	if flags != nil {
+		if file := flags.Lookup(FileFlag); file != nil && file.Value.String() != "" {
+			if err := readFile(v, file.Value.String(), options); err != nil {
+				return err
+			}
+		}
+
+		for _, option := range options {
+			if flag := flags.Lookup(FlagName(option.Key)); flag != nil {
+				if err := v.BindPFlag(option.Key, flag); err != nil {
+					return fmt.Errorf("failed to bind flag %s: %w", flag.Name, err)
+				}
+			}
+		}
+	}
+
+	return Unmarshal(v, out)
+}
+
+// readFile reads a YAML configuration file, which must only set known options.
+func readFile(v *viper.Viper, path string, options []Option) error {
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read configuration file %s: %w", path, err)
+	}
+
+	var errs []error
+
+	for _, key := range v.AllKeys() {
+		if !slices.ContainsFunc(options, func(option Option) bool { return option.Key == key }) {
+			errs = append(errs, fmt.Errorf("unknown option %q", key))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration file %s: %w", path, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Marshal encodes the options of a decoded configuration as YAML, in the format of the configuration file.
+func Marshal(config any, options []Option) ([]byte, error) {
+	var settings map[string]any
+	if err := mapstructure.Decode(config, &settings); err != nil {
+		return nil, fmt.Errorf("failed to encode configuration: %w", err)
+	}
+
+	v := viper.New()
+	for _, option := range options {
+		v.Set(option.Key, lookup(settings, option.Key))
+	}
+
+	data, err := yaml.Marshal(v.AllSettings())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode configuration: %w", err)
+	}
+
+	return data, nil
+}
+
+// lookup returns the value of a dotted key in nested settings.
+func lookup(settings map[string]any, key string) any {
+	parent, name, nested := strings.Cut(key, ".")
+	if !nested {
+		return settings[key]
+	}
+
+	child, _ := settings[parent].(map[string]any)
+
+	return lookup(child, name)
+}
+
+// Unmarshal decodes the configuration into out.
+func Unmarshal(v *viper.Viper, out any) error {
+	decodeHooks := mapstructure.ComposeDecodeHookFunc(
+		mapstructure.TextUnmarshallerHookFunc(),
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+	)
+
+	if err := v.Unmarshal(out, viper.DecodeHook(decodeHooks)); err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type testConfig struct {
+	Address string `mapstructure:"address"`
+	TLS     struct {
+		CertFile string `mapstructure:"cert_file"`
+	} `mapstructure:"tls"`
+	Workers int           `mapstructure:"workers"`
+	Ratio   float64       `mapstructure:"ratio"`
+	Fields  []string      `mapstructure:"fields"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+var testOptions = []Option{
+	{Key: "address", Default: "0.0.0.0:1234", Usage: "address"},
+	{Key: "tls.cert_file", Default: "", Usage: "certificate"},
+	{Key: "workers", Default: 4, Usage: "workers"},
+	{Key: "ratio", Default: 1.0, Usage: "ratio"},
+	{Key: "fields", Default: []string(nil), Usage: "fields"},
+	{Key: "timeout", Default: 10 * time.Second, Usage: "timeout"},
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeConfigFile(t, `
+address: file:1234
+tls:
+  cert_file: file.pem
+workers: 8
+ratio: 0.5
+`)
+
+	t.Setenv("TEST_WORKERS", "16")
+	t.Setenv("TEST_RATIO", "0.25")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddFlags(flags, testOptions)
+
+	if err := flags.Parse([]string{"--config", path, "--ratio", "0.1", "--fields", "a,b", "--timeout", "1m"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	var config testConfig
+	if err := Load(NewViper("TEST"), testOptions, flags, &config); err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	// Flags take precedence over the environment, which takes precedence over the file.
+	if config.Address != "file:1234" {
+		t.Errorf("got address %q, want the file value", config.Address)
+	}
+
+	if config.TLS.CertFile != "file.pem" {
+		t.Errorf("got cert file %q, want the file value", config.TLS.CertFile)
+	}
+
+	if config.Workers != 16 {
+		t.Errorf("got %d workers, want the environment value", config.Workers)
+	}
+
+	if config.Ratio != 0.1 {
+		t.Errorf("got ratio %v, want the flag value", config.Ratio)
+	}
+
+	if config.Timeout != time.Minute {
+		t.Errorf("got timeout %v, want the flag value", config.Timeout)
+	}
+
+	if !reflect.DeepEqual(config.Fields, []string{"a", "b"}) {
+		t.Errorf("got fields %v, want the flag value", config.Fields)
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddFlags(flags, testOptions)
+
+	var config testConfig
+	if err := Load(NewViper("TEST"), testOptions, flags, &config); err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	if config.Address != "0.0.0.0:1234" || config.Workers != 4 || config.Ratio != 1.0 {
+		t.Errorf("got config %+v, want the defaults", config)
+	}
+}
+
+func TestLoadRejectsUnknownFileOptions(t *testing.T) {
+	path := writeConfigFile(t, `
+address: file:1234
+tls:
+  cert: file.pem
+`)
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddFlags(flags, testOptions)
+
+	if err := flags.Parse([]string{"--config", path}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	var config testConfig
+
+	err := Load(NewViper("TEST"), testOptions, flags, &config)
+	if err == nil || !strings.Contains(err.Error(), `unknown option "tls.cert"`) {
+		t.Errorf("got error %v, want an unknown option error", err)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	config := testConfig{Address: "0.0.0.0:1234", Workers: 4, Ratio: 0.5, Fields: []string{"a"}, Timeout: time.Minute}
+	config.TLS.CertFile = "cert.pem"
+
+	data, err := Marshal(config, testOptions)
+	if err != nil {
+		t.Fatalf("failed to marshal config: %v", err)
+	}
+
+	path := writeConfigFile(t, string(data))
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddFlags(flags, testOptions)
+
+	if err := flags.Parse([]string{"--config", path}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	var loaded testConfig
+	if err := Load(NewViper("TEST"), testOptions, flags, &loaded); err != nil {
+		t.Fatalf("failed to load marshaled config: %v\n%s", err, data)
+	}
+
+	if !reflect.DeepEqual(loaded, config) {
+		t.Errorf("got config %+v from\n%s\nwant %+v", loaded, data, config)
+	}
+}
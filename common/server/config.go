@@ -0,0 +1,205 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/agntcy/oasf-sdk/common/auth"
+	"github.com/agntcy/oasf-sdk/common/config"
+)
+
+// Config holds the options shared by every server.
+type Config struct {
+	// ListenAddress is the address of the gRPC server: a TCP "host:port", a unix socket path prefixed
+	// with "unix://", or a socket inherited through systemd socket activation prefixed with "systemd://".
+	// The HTTP and metrics listen addresses support the same formats.
+	ListenAddress string `json:"listen_address,omitempty" mapstructure:"listen_address"`
+
+	// HTTPListenAddress is the address of the HTTP/JSON gateway. The gateway is disabled when empty.
+	HTTPListenAddress string `json:"http_listen_address,omitempty" mapstructure:"http_listen_address"`
+
+	// MetricsListenAddress is the address of the Prometheus metrics endpoint. Metrics are not served when empty.
+	MetricsListenAddress string `json:"metrics_listen_address,omitempty" mapstructure:"metrics_listen_address"`
+
+	// UnixSocketMode is the octal file mode of the unix sockets the server listens on, eg. "0660".
+	UnixSocketMode string `json:"unix_socket_mode,omitempty" mapstructure:"unix_socket_mode"`
+
+	// ShutdownTimeout bounds how long in-flight requests are drained when the server stops, after which
+	// they are canceled. DefaultShutdownTimeout is used when zero.
+	ShutdownTimeout time.Duration `json:"shutdown_timeout,omitempty" mapstructure:"shutdown_timeout"`
+
+	TLS TLSConfig `json:"tls" mapstructure:"tls"`
+
+	HealthProbe HealthProbeConfig `json:"health_probe" mapstructure:"health_probe"`
+
+	Auth auth.Config `json:"auth" mapstructure:"auth"`
+
+	Limits LimitsConfig `json:"limits" mapstructure:"limits"`
+
+	Tracing TracingConfig `json:"tracing" mapstructure:"tracing"`
+
+	Logging LoggingConfig `json:"logging" mapstructure:"logging"`
+}
+
+// DefaultShutdownTimeout drains requests for less than the 30 seconds Kubernetes waits before killing pods.
+const DefaultShutdownTimeout = 20 * time.Second
+
+// Options returns the server options nested under key, with the given defaults.
+func Options(key string, defaults Config) []config.Option {
+	tlsKey := config.Key(key, "tls")
+	healthProbeKey := config.Key(key, "health_probe")
+	authKey := config.Key(key, "auth")
+	limitsKey := config.Key(key, "limits")
+	tracingKey := config.Key(key, "tracing")
+	loggingKey := config.Key(key, "logging")
+
+	return []config.Option{
+		{Key: config.Key(key, "listen_address"), Default: defaults.ListenAddress, Usage: "address of the gRPC server"},
+		{Key: config.Key(key, "http_listen_address"), Default: defaults.HTTPListenAddress, Usage: "address of the HTTP/JSON gateway, disabled when empty"},
+		{Key: config.Key(key, "metrics_listen_address"), Default: defaults.MetricsListenAddress, Usage: "address of the Prometheus metrics endpoint, disabled when empty"},
+		{Key: config.Key(key, "shutdown_timeout"), Default: cmp.Or(defaults.ShutdownTimeout, DefaultShutdownTimeout), Usage: "how long in-flight requests are drained when the server stops"},
+		{Key: config.Key(key, "unix_socket_mode"), Default: cmp.Or(defaults.UnixSocketMode, DefaultUnixSocketMode), Usage: "octal file mode of the unix sockets the server listens on"},
+
+		{Key: config.Key(tlsKey, "cert_file"), Default: defaults.TLS.CertFile, Usage: "PEM certificate chain of the server, TLS is disabled when empty"},
+		{Key: config.Key(tlsKey, "key_file"), Default: defaults.TLS.KeyFile, Usage: "PEM private key of the server"},
+		{Key: config.Key(tlsKey, "client_ca_file"), Default: defaults.TLS.ClientCAFile, Usage: "PEM bundle of the CAs client certificates are verified against"},
+
+		{Key: config.Key(healthProbeKey, "ca_file"), Default: defaults.HealthProbe.CAFile, Usage: "PEM bundle of the CAs the health probe verifies the server certificate against, the system CAs when empty"},
+		{Key: config.Key(healthProbeKey, "server_name"), Default: cmp.Or(defaults.HealthProbe.ServerName, DefaultProbeServerName), Usage: "name the health probe verifies the server certificate for"},
+		{Key: config.Key(healthProbeKey, "cert_file"), Default: defaults.HealthProbe.CertFile, Usage: "PEM client certificate of the health probe, for servers that require client certificates"},
+		{Key: config.Key(healthProbeKey, "key_file"), Default: defaults.HealthProbe.KeyFile, Usage: "PEM private key of the client certificate of the health probe"},
+
+		{Key: config.Key(authKey, "api_keys_file"), Default: defaults.Auth.APIKeysFile, Usage: "YAML file mapping principal names to their API key"},
+		{Key: config.Key(authKey, "jwks_file"), Default: defaults.Auth.JWKSFile, Usage: "JWKS file of the keys JWTs are verified against"},
+		{Key: config.Key(authKey, "jwt_issuer"), Default: defaults.Auth.JWTIssuer, Usage: "issuer JWTs must be issued by"},
+		{Key: config.Key(authKey, "jwt_audience"), Default: defaults.Auth.JWTAudience, Usage: "audience JWTs must be issued for"},
+		{Key: config.Key(authKey, "rules_file"), Default: defaults.Auth.RulesFile, Usage: "YAML file of the authorization rules"},
+
+		{Key: config.Key(limitsKey, "max_recv_message_size"), Default: cmp.Or(defaults.Limits.MaxRecvMessageSize, DefaultMaxMessageSize), Usage: "maximum size in bytes of a received message"},
+		{Key: config.Key(limitsKey, "max_send_message_size"), Default: cmp.Or(defaults.Limits.MaxSendMessageSize, DefaultMaxMessageSize), Usage: "maximum size in bytes of a sent message"},
+		{Key: config.Key(limitsKey, "max_stream_items"), Default: defaults.Limits.MaxStreamItems, Usage: "maximum number of messages received on a stream, unlimited when 0"},
+		{Key: config.Key(limitsKey, "max_stream_duration"), Default: defaults.Limits.MaxStreamDuration, Usage: "maximum duration of a stream, unlimited when 0"},
+		{Key: config.Key(limitsKey, "rate_limit"), Default: defaults.Limits.RateLimit, Usage: "requests per second allowed for each client, disabled when 0"},
+		{Key: config.Key(limitsKey, "rate_limit_burst"), Default: defaults.Limits.RateLimitBurst, Usage: "requests a client can send at once, the rate limit when 0"},
+		{Key: config.Key(limitsKey, "peer_rate_limit"), Default: defaults.Limits.PeerRateLimit, Usage: "requests per second allowed for each peer before authentication, disabled when 0"},
+		{Key: config.Key(limitsKey, "peer_rate_limit_burst"), Default: defaults.Limits.PeerRateLimitBurst, Usage: "requests a peer can send at once, the peer rate limit when 0"},
+
+		{Key: config.Key(tracingKey, "otlp_endpoint"), Default: defaults.Tracing.OTLPEndpoint, Usage: "OTLP/gRPC endpoint traces are exported to, disabled when empty"},
+		{Key: config.Key(tracingKey, "otlp_insecure"), Default: defaults.Tracing.OTLPInsecure, Usage: "export traces without TLS"},
+		{Key: config.Key(tracingKey, "sample_ratio"), Default: defaults.Tracing.SampleRatio, Usage: "ratio of new traces that are sampled, between 0 and 1"},
+		{Key: config.Key(tracingKey, "service_name"), Default: defaults.Tracing.ServiceName, Usage: "service name of the exported traces"},
+
+		{Key: config.Key(loggingKey, "level"), Default: defaults.Logging.Level.String(), Usage: "minimum level of logged messages: debug, info, warn or error"},
+		{Key: config.Key(loggingKey, "redact_fields"), Default: defaults.Logging.RedactFields, Usage: "additional fields redacted from logged payloads"},
+	}
+}
+
+// Validate checks the consistency of the server options and that the files they refer to exist,
+// so that misconfigurations are reported at startup.
+func (c Config) Validate() error {
+	var errs []error
+
+	if c.ListenAddress == "" {
+		errs = append(errs, errors.New("listen_address: must be set"))
+	}
+
+	addresses := map[string]string{}
+
+	for _, option := range []struct{ key, address string }{
+		{"listen_address", c.ListenAddress},
+		{"http_listen_address", c.HTTPListenAddress},
+		{"metrics_listen_address", c.MetricsListenAddress},
+	} {
+		if option.address == "" {
+			continue
+		}
+
+		if err := validateAddress(option.address); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid address %q: %w", option.key, option.address, err))
+		} else if other, ok := addresses[option.address]; ok {
+			errs = append(errs, fmt.Errorf("%s: address %q is already used by %s", option.key, option.address, other))
+		}
+
+		addresses[option.address] = option.key
+	}
+
+	if c.ShutdownTimeout < 0 {
+		errs = append(errs, fmt.Errorf("shutdown_timeout: %v is negative", c.ShutdownTimeout))
+	}
+
+	if c.UnixSocketMode != "" {
+		if _, err := parseSocketMode(c.UnixSocketMode); err != nil {
+			errs = append(errs, fmt.Errorf("unix_socket_mode: %w", err))
+		}
+	}
+
+	if c.TLS.Enabled() && (c.TLS.CertFile == "" || c.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("tls: both cert_file and key_file must be set"))
+	}
+
+	if c.TLS.ClientCAFile != "" && !c.TLS.Enabled() {
+		errs = append(errs, errors.New("tls.client_ca_file: requires cert_file and key_file"))
+	}
+
+	if (c.HealthProbe.CertFile == "") != (c.HealthProbe.KeyFile == "") {
+		errs = append(errs, errors.New("health_probe: both cert_file and key_file must be set"))
+	}
+
+	if c.Auth.RulesFile != "" && !c.Auth.Enabled() {
+		errs = append(errs, errors.New("auth.rules_file: requires api_keys_file or jwks_file"))
+	}
+
+	if (c.Auth.JWTIssuer != "" || c.Auth.JWTAudience != "") && c.Auth.JWKSFile == "" {
+		errs = append(errs, errors.New("auth: jwt_issuer and jwt_audience require jwks_file"))
+	}
+
+	for _, option := range []struct {
+		key   string
+		value float64
+	}{
+		{"limits.max_recv_message_size", float64(c.Limits.MaxRecvMessageSize)},
+		{"limits.max_send_message_size", float64(c.Limits.MaxSendMessageSize)},
+		{"limits.max_stream_items", float64(c.Limits.MaxStreamItems)},
+		{"limits.max_stream_duration", float64(c.Limits.MaxStreamDuration)},
+		{"limits.rate_limit", c.Limits.RateLimit},
+		{"limits.rate_limit_burst", float64(c.Limits.RateLimitBurst)},
+		{"limits.peer_rate_limit", c.Limits.PeerRateLimit},
+		{"limits.peer_rate_limit_burst", float64(c.Limits.PeerRateLimitBurst)},
+	} {
+		if option.value < 0 {
+			errs = append(errs, fmt.Errorf("%s: must not be negative", option.key))
+		}
+	}
+
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio: %v is not between 0 and 1", c.Tracing.SampleRatio))
+	}
+
+	for _, option := range []struct{ key, path string }{
+		{"tls.cert_file", c.TLS.CertFile},
+		{"tls.key_file", c.TLS.KeyFile},
+		{"tls.client_ca_file", c.TLS.ClientCAFile},
+		{"health_probe.ca_file", c.HealthProbe.CAFile},
+		{"health_probe.cert_file", c.HealthProbe.CertFile},
+		{"health_probe.key_file", c.HealthProbe.KeyFile},
+		{"auth.api_keys_file", c.Auth.APIKeysFile},
+		{"auth.jwks_file", c.Auth.JWKSFile},
+		{"auth.rules_file", c.Auth.RulesFile},
+	} {
+		if option.path == "" {
+			continue
+		}
+
+		if _, err := os.Stat(option.path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", option.key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,91 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agntcy/oasf-sdk/common/auth"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: Config{ListenAddress: "0.0.0.0:31235", HTTPListenAddress: "0.0.0.0:31245"},
+		},
+		{
+			name:   "unix and systemd sockets",
+			config: Config{ListenAddress: "unix:///run/oasf/validation.sock", HTTPListenAddress: "systemd://http", UnixSocketMode: "0660"},
+		},
+		{
+			name:    "invalid socket mode",
+			config:  Config{ListenAddress: "unix:///run/oasf/validation.sock", UnixSocketMode: "rw-rw----"},
+			wantErr: `unix_socket_mode: invalid file mode "rw-rw----"`,
+		},
+		{
+			name:    "missing listen address",
+			config:  Config{},
+			wantErr: "listen_address: must be set",
+		},
+		{
+			name:    "address without port",
+			config:  Config{ListenAddress: "0.0.0.0"},
+			wantErr: `listen_address: invalid address "0.0.0.0"`,
+		},
+		{
+			name:    "shared address",
+			config:  Config{ListenAddress: "0.0.0.0:31235", MetricsListenAddress: "0.0.0.0:31235"},
+			wantErr: "metrics_listen_address: address \"0.0.0.0:31235\" is already used by listen_address",
+		},
+		{
+			name:    "client CA without TLS",
+			config:  Config{ListenAddress: "0.0.0.0:31235", TLS: TLSConfig{ClientCAFile: "/dev/null"}},
+			wantErr: "tls.client_ca_file: requires cert_file and key_file",
+		},
+		{
+			name:    "health probe certificate without key",
+			config:  Config{ListenAddress: "0.0.0.0:31235", HealthProbe: HealthProbeConfig{CertFile: "/dev/null"}},
+			wantErr: "health_probe: both cert_file and key_file must be set",
+		},
+		{
+			name:    "rules without authentication",
+			config:  Config{ListenAddress: "0.0.0.0:31235", Auth: auth.Config{RulesFile: "/dev/null"}},
+			wantErr: "auth.rules_file: requires api_keys_file or jwks_file",
+		},
+		{
+			name:    "missing file",
+			config:  Config{ListenAddress: "0.0.0.0:31235", Auth: auth.Config{APIKeysFile: "/nonexistent/api-keys.yaml"}},
+			wantErr: "auth.api_keys_file: stat /nonexistent/api-keys.yaml",
+		},
+		{
+			name:    "sample ratio out of range",
+			config:  Config{ListenAddress: "0.0.0.0:31235", Tracing: TracingConfig{SampleRatio: 1.5}},
+			wantErr: "tracing.sample_ratio: 1.5 is not between 0 and 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got error %v, want none", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details of the errors returned by the SDK services.
+const ErrorDomain = "oasf-sdk.agntcy.org"
+
+// ErrorReasonInternal is the ErrorInfo reason of the errors that are not caused by the request.
+const ErrorReasonInternal = "INTERNAL"
+
+// ErrorStatus returns a status error of err, with an ErrorInfo detail of reason and metadata
+// so that clients can branch on the reason rather than on the message, along with a
+// BadRequest detail of the request fields at fault, if any.
+func ErrorStatus(code codes.Code, err error, reason string, metadata map[string]string, violations ...*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(code, err.Error())
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	}}
+
+	if len(violations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	detailed, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// FieldViolation returns the BadRequest field violation of a request field, eg. "record.schema_version".
+func FieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// gatewayNetwork is the network of the in-memory connection between the gateway and the gRPC server.
+	gatewayNetwork = "bufconn"
+
+	// gatewayBufferSize is the buffer size of the in-memory connection between the gateway and the gRPC server.
+	gatewayBufferSize = 1 << 20
+
+	gatewayReadHeaderTimeout = 10 * time.Second
+)
+
+// gateway serves the HTTP/JSON API of the gRPC server.
+// Requests are forwarded to the gRPC server over an in-memory connection, so that they
+// go through the same interceptors and streaming support as gRPC requests.
+type gateway struct {
+	listener   *bufconn.Listener
+	conn       *grpc.ClientConn
+	httpServer *http.Server
+}
+
+// newGateway creates the gateway of services on address. It serves HTTPS when tlsConfig is set.
+func newGateway(ctx context.Context, address string, tlsConfig *tls.Config, limits LimitsConfig, services []Service) (*gateway, error) {
+	listener := bufconn.Listen(gatewayBufferSize)
+
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(limits.callOptions()...),
+		// Forward the trace context of HTTP requests to the gRPC server.
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gateway connection: %w", err)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		// Use the proto field names, as in the OpenAPI document, and report unset fields
+		// so that clients do not need to know the proto defaults.
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+		}),
+		// Streaming requests and responses are newline delimited JSON.
+		runtime.WithMarshalerOption("application/x-ndjson", &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+		}),
+	)
+
+	for _, service := range services {
+		if service.RegisterGateway != nil {
+			if err := service.RegisterGateway(ctx, mux, conn); err != nil {
+				_ = conn.Close()
+
+				return nil, fmt.Errorf("failed to register %s gateway: %w", service.Name, err)
+			}
+		}
+
+		if service.OpenAPI != nil {
+			if err := mux.HandlePath(http.MethodGet, service.OpenAPIPath, serveOpenAPI(service.OpenAPI)); err != nil {
+				_ = conn.Close()
+
+				return nil, fmt.Errorf("failed to register %s OpenAPI document: %w", service.Name, err)
+			}
+		}
+	}
+
+	return &gateway{
+		listener: listener,
+		conn:     conn,
+		httpServer: &http.Server{
+			Addr:              address,
+			Handler:           otelhttp.NewHandler(mux, "gateway"),
+			ReadHeaderTimeout: gatewayReadHeaderTimeout,
+			TLSConfig:         tlsConfig,
+		},
+	}, nil
+}
+
+// gatewayHeaderMatcher forwards HTTP headers as gRPC metadata, as runtime.DefaultHeaderMatcher does,
+// except the address of the client, which is only set by the gateway.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if !ok || strings.EqualFold(name, forwardedForHeader) {
+		return "", false
+	}
+
+	return name, true
+}
+
+// start serves the gateway on listen, or on its address when nil, reporting the errors of its goroutines to errs.
+func (g *gateway) start(grpcServer *grpc.Server, listen net.Listener, socketMode string, errs chan<- error) error {
+	if listen == nil {
+		var err error
+		if listen, err = listenOn(g.httpServer.Addr, socketMode); err != nil {
+			return err
+		}
+	}
+
+	if g.httpServer.TLSConfig != nil {
+		listen = tls.NewListener(listen, g.httpServer.TLSConfig)
+	}
+
+	go func() {
+		if err := grpcServer.Serve(g.listener); err != nil {
+			errs <- fmt.Errorf("gateway connection: %w", err)
+		}
+	}()
+
+	go func() {
+		slog.Info("Starting HTTP gateway", "address", g.httpServer.Addr)
+
+		if err := g.httpServer.Serve(listen); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errs <- fmt.Errorf("HTTP gateway: %w", err)
+		}
+	}()
+
+	return nil
+}
+
+// close drains the requests of the gateway until ctx is done, and then closes their connections.
+func (g *gateway) close(ctx context.Context) {
+	if err := g.httpServer.Shutdown(ctx); err != nil {
+		slog.Warn("Failed to drain HTTP gateway, closing the remaining connections", "error", err)
+		_ = g.httpServer.Close()
+	}
+
+	_ = g.conn.Close()
+}
+
+func serveOpenAPI(document []byte) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/agntcy/oasf-sdk/common/address"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// probeTimeout bounds the health check done by Probe.
+const probeTimeout = 5 * time.Second
+
+// DefaultProbeServerName is the name the certificate of the server is verified for by Probe.
+const DefaultProbeServerName = "localhost"
+
+// HealthProbeConfig configures how Probe connects to a server that serves TLS.
+type HealthProbeConfig struct {
+	// CAFile is a PEM bundle of the CAs the certificate of the server is verified against.
+	// The system CAs are used when empty.
+	CAFile string `json:"ca_file,omitempty" mapstructure:"ca_file"`
+
+	// ServerName is the name the certificate of the server is verified for.
+	// DefaultProbeServerName is used when empty.
+	ServerName string `json:"server_name,omitempty" mapstructure:"server_name"`
+
+	// CertFile is the PEM encoded client certificate presented to servers that require client
+	// certificates. It must be valid for client authentication and issued by one of the client CAs.
+	CertFile string `json:"cert_file,omitempty" mapstructure:"cert_file"`
+
+	// KeyFile is the PEM encoded private key of the client certificate.
+	KeyFile string `json:"key_file,omitempty" mapstructure:"key_file"`
+}
+
+// Probe checks the health of the local server running with cfg, as served by the
+// grpc.health.v1.Health service. An empty service checks the server as a whole.
+// It returns an error unless the status is SERVING.
+func Probe(ctx context.Context, cfg Config, service string) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	creds, err := probeCredentials(cfg.TLS, cfg.HealthProbe)
+	if err != nil {
+		return err
+	}
+
+	target, err := address.ClientTarget(cfg.ListenAddress)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return fmt.Errorf("failed to create health check client: %w", err)
+	}
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return fmt.Errorf("failed to check health: %w", err)
+	}
+
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("server is %s", resp.GetStatus())
+	}
+
+	return nil
+}
+
+// probeCredentials returns the credentials to reach a local server serving tls, as configured by probe.
+func probeCredentials(tlsConfig TLSConfig, probe HealthProbeConfig) (credentials.TransportCredentials, error) {
+	if !tlsConfig.Enabled() {
+		return insecure.NewCredentials(), nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cmp.Or(probe.ServerName, DefaultProbeServerName),
+	}
+
+	if probe.CAFile != "" {
+		pem, err := os.ReadFile(probe.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read health probe CA file: %w", err)
+		}
+
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in health probe CA file")
+		}
+	}
+
+	switch {
+	case probe.CertFile != "":
+		cert, err := tls.LoadX509KeyPair(probe.CertFile, probe.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load health probe certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	case tlsConfig.ClientCAFile != "":
+		return nil, errors.New("health_probe.cert_file and health_probe.key_file must be set to probe a server that requires client certificates")
+	}
+
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,282 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/agntcy/oasf-sdk/common/auth"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// DefaultMaxMessageSize is the default maximum size of received and sent messages, as in gRPC.
+const DefaultMaxMessageSize = 4 << 20
+
+// ErrorReasonRateLimited is the ErrorInfo reason of the requests rejected by the rate limit.
+const ErrorReasonRateLimited = "RATE_LIMITED"
+
+// rateLimiterSweepInterval is how often the rate limiters of clients that stopped sending requests are removed.
+const rateLimiterSweepInterval = time.Minute
+
+// LimitsConfig bounds the resources used by each request and client, so that a single
+// client cannot exhaust the server.
+type LimitsConfig struct {
+	// MaxRecvMessageSize is the maximum size in bytes of a received message, eg. a record.
+	// DefaultMaxMessageSize is used when zero.
+	MaxRecvMessageSize int `json:"max_recv_message_size,omitempty" mapstructure:"max_recv_message_size"`
+
+	// MaxSendMessageSize is the maximum size in bytes of a sent message.
+	// DefaultMaxMessageSize is used when zero.
+	MaxSendMessageSize int `json:"max_send_message_size,omitempty" mapstructure:"max_send_message_size"`
+
+	// MaxStreamItems is the maximum number of messages received on a single stream. Unlimited when zero.
+	MaxStreamItems int `json:"max_stream_items,omitempty" mapstructure:"max_stream_items"`
+
+	// MaxStreamDuration is the maximum duration of a single stream, after which it is canceled.
+	// Unlimited when zero.
+	MaxStreamDuration time.Duration `json:"max_stream_duration,omitempty" mapstructure:"max_stream_duration"`
+
+	// RateLimit is the number of requests per second allowed for each client, which is the
+	// authenticated principal or else the address of the peer. Disabled when zero.
+	RateLimit float64 `json:"rate_limit,omitempty" mapstructure:"rate_limit"`
+
+	// RateLimitBurst is the number of requests a client can send at once, on top of the rate limit.
+	// The rate limit rounded up is used when zero.
+	RateLimitBurst int `json:"rate_limit_burst,omitempty" mapstructure:"rate_limit_burst"`
+
+	// PeerRateLimit is the number of requests per second allowed for each peer address, before
+	// requests are authenticated, so that requests with invalid credentials are limited too.
+	// Disabled when zero.
+	PeerRateLimit float64 `json:"peer_rate_limit,omitempty" mapstructure:"peer_rate_limit"`
+
+	// PeerRateLimitBurst is the number of requests a peer can send at once, on top of the peer rate limit.
+	// The peer rate limit rounded up is used when zero.
+	PeerRateLimitBurst int `json:"peer_rate_limit_burst,omitempty" mapstructure:"peer_rate_limit_burst"`
+}
+
+// serverOptions returns the gRPC server options enforcing the message size limits.
+func (c LimitsConfig) serverOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cmp.Or(c.MaxRecvMessageSize, DefaultMaxMessageSize)),
+		grpc.MaxSendMsgSize(cmp.Or(c.MaxSendMessageSize, DefaultMaxMessageSize)),
+	}
+}
+
+// callOptions returns the options of the calls forwarded by the gateway, which accepts
+// the messages allowed by the server.
+func (c LimitsConfig) callOptions() []grpc.CallOption {
+	return []grpc.CallOption{
+		grpc.MaxCallSendMsgSize(cmp.Or(c.MaxRecvMessageSize, DefaultMaxMessageSize)),
+		grpc.MaxCallRecvMsgSize(cmp.Or(c.MaxSendMessageSize, DefaultMaxMessageSize)),
+	}
+}
+
+var rateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "oasf",
+	Subsystem: "server",
+	Name:      "rate_limited_requests_total",
+	Help:      "Number of requests rejected by the rate limit, by method.",
+}, []string{"grpc_method"})
+
+// limiter enforces a rate limit, for the clients identified by key, and the stream limits of a server.
+type limiter struct {
+	rateLimit         float64
+	burst             int
+	key               func(context.Context) string
+	maxStreamItems    int
+	maxStreamDuration time.Duration
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+// clientLimiter is the token bucket of a client.
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newLimiter creates the limiter of the authenticated requests, which limits each client and stream.
+func newLimiter(cfg LimitsConfig) *limiter {
+	return &limiter{
+		rateLimit:         cfg.RateLimit,
+		burst:             cfg.RateLimitBurst,
+		key:               clientKey,
+		maxStreamItems:    cfg.MaxStreamItems,
+		maxStreamDuration: cfg.MaxStreamDuration,
+		clients:           make(map[string]*clientLimiter),
+		lastSweep:         time.Now(),
+	}
+}
+
+// newPeerLimiter creates the limiter of the requests before they are authenticated, which limits each peer.
+func newPeerLimiter(cfg LimitsConfig) *limiter {
+	return &limiter{
+		rateLimit: cfg.PeerRateLimit,
+		burst:     cfg.PeerRateLimitBurst,
+		key:       peerKey,
+		clients:   make(map[string]*clientLimiter),
+		lastSweep: time.Now(),
+	}
+}
+
+func (l *limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (l *limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allow(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		if l.maxStreamItems == 0 && l.maxStreamDuration == 0 {
+			return handler(srv, stream)
+		}
+
+		limited := &limitedStream{ServerStream: stream, ctx: stream.Context(), maxItems: l.maxStreamItems}
+
+		if l.maxStreamDuration > 0 {
+			var cancel context.CancelFunc
+
+			limited.ctx, cancel = context.WithTimeout(limited.ctx, l.maxStreamDuration)
+			defer cancel()
+		}
+
+		return handler(srv, limited)
+	}
+}
+
+// allow takes a token from the bucket of the client of a request, or returns a ResourceExhausted
+// error with the delay after which the client can retry.
+func (l *limiter) allow(ctx context.Context, method string) error {
+	if l.rateLimit <= 0 || strings.HasPrefix(method, healthMethodPrefix) {
+		return nil
+	}
+
+	now := time.Now()
+
+	reservation := l.clientLimiter(l.key(ctx), now).ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+
+	if delay == 0 {
+		return nil
+	}
+
+	reservation.CancelAt(now)
+	rateLimitedRequests.WithLabelValues(method).Inc()
+
+	st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: ErrorReasonRateLimited,
+			Domain: ErrorDomain,
+			Metadata: map[string]string{
+				"rate_limit": fmt.Sprint(l.rateLimit),
+			},
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)},
+	)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	return st.Err()
+}
+
+// clientLimiter returns the token bucket of a client, and removes the buckets of the clients
+// that have not sent requests since the last sweep and whose bucket is full again.
+func (l *limiter) clientLimiter(key string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	burst := cmp.Or(l.burst, max(1, int(math.Ceil(l.rateLimit))))
+
+	if now.Sub(l.lastSweep) > rateLimiterSweepInterval {
+		for client, cl := range l.clients {
+			if cl.lastSeen.Before(l.lastSweep) && cl.limiter.TokensAt(now) >= float64(burst) {
+				delete(l.clients, client)
+			}
+		}
+
+		l.lastSweep = now
+	}
+
+	cl, ok := l.clients[key]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(rate.Limit(l.rateLimit), burst)}
+		l.clients[key] = cl
+	}
+
+	cl.lastSeen = now
+
+	return cl.limiter
+}
+
+// clientKey identifies the client of a request: its principal when authenticated, or else its peer.
+func clientKey(ctx context.Context) string {
+	if principal := auth.Principal(ctx); principal != "" {
+		return "principal:" + principal
+	}
+
+	return peerKey(ctx)
+}
+
+// peerKey identifies the peer of a request by the host of its address, so that the connections
+// of a peer share a single limit.
+func peerKey(ctx context.Context) string {
+	address := peerAddress(ctx)
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+
+	return "peer:" + address
+}
+
+// limitedStream enforces the limits of a stream: its context is canceled after the maximum
+// duration, and receiving more than the maximum number of messages fails.
+type limitedStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	maxItems int
+	received int
+}
+
+func (s *limitedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *limitedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.received++
+	if s.maxItems > 0 && s.received > s.maxItems {
+		return status.Errorf(codes.ResourceExhausted, "stream exceeds the maximum of %d messages", s.maxItems)
+	}
+
+	return nil
+}
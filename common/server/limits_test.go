@@ -0,0 +1,255 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agntcy/oasf-sdk/common/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func peerContext(ctx context.Context, address string) context.Context {
+	addr, err := net.ResolveTCPAddr("tcp", address)
+	if err != nil {
+		panic(err)
+	}
+
+	return peer.NewContext(ctx, &peer.Peer{Addr: addr})
+}
+
+func TestLimiterRateLimitsEachClient(t *testing.T) {
+	const method = "/validation.v1.ValidationService/ValidateRecord"
+
+	l := newLimiter(LimitsConfig{RateLimit: 1, RateLimitBurst: 2})
+
+	client := peerContext(t.Context(), "10.0.0.1:40000")
+
+	for i := range 2 {
+		if err := l.allow(client, method); err != nil {
+			t.Fatalf("request %d within the burst was rejected: %v", i, err)
+		}
+	}
+
+	// The other connections of the client share its limit.
+	err := l.allow(peerContext(t.Context(), "10.0.0.1:40001"), method)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("got error %v after the burst, want ResourceExhausted", err)
+	}
+
+	var retryInfo *errdetails.RetryInfo
+
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = d
+		}
+	}
+
+	if retryInfo == nil {
+		t.Fatal("expected a RetryInfo detail")
+	}
+
+	if delay := retryInfo.GetRetryDelay().AsDuration(); delay <= 0 || delay > time.Second {
+		t.Errorf("got retry delay %v, want at most the time to refill a token", delay)
+	}
+
+	// Other clients are not limited.
+	if err := l.allow(peerContext(t.Context(), "10.0.0.2:40000"), method); err != nil {
+		t.Errorf("request of another client was rejected: %v", err)
+	}
+
+	// Health checks are not limited.
+	if err := l.allow(client, healthMethodPrefix+"Check"); err != nil {
+		t.Errorf("health check was rejected: %v", err)
+	}
+}
+
+// gatewayAddr is the address of the in-memory connection of the gateway.
+type gatewayAddr struct{}
+
+func (gatewayAddr) Network() string { return gatewayNetwork }
+func (gatewayAddr) String() string  { return gatewayNetwork }
+
+// gatewayContext returns the context of a request forwarded by the gateway with forwardedFor.
+func gatewayContext(ctx context.Context, forwardedFor ...string) context.Context {
+	ctx = metadata.NewIncomingContext(ctx, metadata.MD{forwardedForHeader: forwardedFor})
+
+	return peer.NewContext(ctx, &peer.Peer{Addr: gatewayAddr{}})
+}
+
+func TestLimiterIgnoresSpoofedForwardedFor(t *testing.T) {
+	const method = "/validation.v1.ValidationService/ValidateRecord"
+
+	l := newLimiter(LimitsConfig{RateLimit: 1, RateLimitBurst: 1})
+
+	// The gateway appends the address of the HTTP client to the addresses sent by the client.
+	if err := l.allow(gatewayContext(t.Context(), "192.0.2.1, 10.0.0.1"), method); err != nil {
+		t.Fatalf("first request was rejected: %v", err)
+	}
+
+	err := l.allow(gatewayContext(t.Context(), "192.0.2.2, 10.0.0.1"), method)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got error %v for a spoofed address, want ResourceExhausted", err)
+	}
+
+	// Values set by clients before the value of the gateway are ignored too.
+	err = l.allow(gatewayContext(t.Context(), "192.0.2.3", "10.0.0.1"), method)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got error %v for a spoofed value, want ResourceExhausted", err)
+	}
+
+	// Spoofing the address of another client does not use its limit.
+	if err := l.allow(gatewayContext(t.Context(), "10.0.0.1, 10.0.0.2"), method); err != nil {
+		t.Errorf("request of another client was rejected: %v", err)
+	}
+
+	if len(l.clients) != 2 {
+		t.Errorf("got %d rate limited clients, want 2", len(l.clients))
+	}
+}
+
+func TestGatewayHeaderMatcher(t *testing.T) {
+	tests := []struct {
+		key    string
+		want   string
+		wantOK bool
+	}{
+		{key: "Grpc-Metadata-X-Forwarded-For"},
+		{key: "Grpc-Metadata-x-forwarded-for"},
+		{key: "Grpc-Metadata-Request-Id", want: "Request-Id", wantOK: true},
+		{key: "Authorization", want: "grpcgateway-Authorization", wantOK: true},
+		{key: "X-Custom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok := gatewayHeaderMatcher(tt.key)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("got %q, %t, want %q, %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestLimiterEvictsIdleClients(t *testing.T) {
+	l := newLimiter(LimitsConfig{RateLimit: 1})
+	start := l.lastSweep
+
+	l.clientLimiter("idle", start.Add(time.Second))
+
+	// Clients are only evicted once they have not been seen for a whole sweep interval.
+	l.clientLimiter("active", start.Add(rateLimiterSweepInterval+2*time.Second))
+	if _, ok := l.clients["idle"]; !ok {
+		t.Fatal("client seen during the last sweep interval was evicted")
+	}
+
+	l.clientLimiter("active", start.Add(2*rateLimiterSweepInterval+4*time.Second))
+	if _, ok := l.clients["idle"]; ok {
+		t.Error("idle client was not evicted")
+	}
+
+	if _, ok := l.clients["active"]; !ok {
+		t.Error("active client was evicted")
+	}
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	l := newLimiter(LimitsConfig{})
+
+	client := peerContext(t.Context(), "10.0.0.1:40000")
+
+	for range 100 {
+		if err := l.allow(client, "/validation.v1.ValidationService/ValidateRecord"); err != nil {
+			t.Fatalf("request was rejected without a rate limit: %v", err)
+		}
+	}
+}
+
+// countingStream is a stream that receives messages forever.
+type countingStream struct {
+	grpc.ServerStream
+}
+
+func (countingStream) Context() context.Context {
+	return context.Background()
+}
+
+func (countingStream) RecvMsg(any) error {
+	return nil
+}
+
+func TestLimiterStreamLimits(t *testing.T) {
+	l := newLimiter(LimitsConfig{MaxStreamItems: 3, MaxStreamDuration: time.Minute})
+
+	handler := func(_ any, stream grpc.ServerStream) error {
+		if _, ok := stream.Context().Deadline(); !ok {
+			t.Error("expected the stream to have a deadline")
+		}
+
+		for {
+			if err := stream.RecvMsg(nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	err := l.StreamServerInterceptor()(nil, countingStream{}, &grpc.StreamServerInfo{FullMethod: "/test.v1.TestService/Stream"}, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got error %v, want ResourceExhausted", err)
+	}
+}
+
+func TestServerRejectsLargeMessages(t *testing.T) {
+	server, conn := startTestServer(t, Config{Limits: LimitsConfig{MaxRecvMessageSize: 1024}})
+	t.Cleanup(server.Close)
+
+	client := healthpb.NewHealthClient(conn)
+
+	if _, err := client.Check(t.Context(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("failed to check health: %v", err)
+	}
+
+	_, err := client.Check(t.Context(), &healthpb.HealthCheckRequest{Service: strings.Repeat("x", 2048)})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got error %v for a message over the limit, want ResourceExhausted", err)
+	}
+}
+
+func TestServerLimitsPeersBeforeAuthentication(t *testing.T) {
+	apiKeysFile := filepath.Join(t.TempDir(), "api-keys.yaml")
+	if err := os.WriteFile(apiKeysFile, []byte("ci: ci-key\n"), 0o600); err != nil {
+		t.Fatalf("failed to write API keys file: %v", err)
+	}
+
+	server, conn := startTestServer(t, Config{
+		Auth:   auth.Config{APIKeysFile: apiKeysFile},
+		Limits: LimitsConfig{PeerRateLimit: 1, PeerRateLimitBurst: 1},
+	})
+	t.Cleanup(server.Close)
+
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "authorization", "Bearer invalid-key")
+
+	err := conn.Invoke(ctx, testCheckMethod, &healthpb.HealthCheckRequest{}, &healthpb.HealthCheckResponse{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("got error %v for an invalid key, want Unauthenticated", err)
+	}
+
+	// Requests with invalid credentials use the limit of the peer.
+	err = conn.Invoke(ctx, testCheckMethod, &healthpb.HealthCheckRequest{}, &healthpb.HealthCheckResponse{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got error %v after the peer burst, want ResourceExhausted", err)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/agntcy/oasf-sdk/common/address"
+)
+
+const (
+	// unixScheme prefixes the path of a unix domain socket listen address.
+	unixScheme = address.UnixScheme
+
+	// systemdScheme prefixes the name of a listener inherited through systemd socket activation.
+	systemdScheme = address.SystemdScheme
+
+	// DefaultUnixSocketMode is the default file mode of unix domain sockets.
+	DefaultUnixSocketMode = "0660"
+
+	// systemdFirstFD is the first file descriptor passed by systemd, after stdin, stdout and stderr.
+	systemdFirstFD = 3
+
+	// staleSocketTimeout bounds the check of whether an existing unix socket is still in use.
+	staleSocketTimeout = time.Second
+)
+
+// validateAddress checks a listen address: a TCP "host:port", a unix socket path
+// prefixed with "unix://", or an inherited systemd socket prefixed with "systemd://".
+func validateAddress(address string) error {
+	switch {
+	case strings.HasPrefix(address, unixScheme):
+		if strings.TrimPrefix(address, unixScheme) == "" {
+			return errors.New("missing unix socket path")
+		}
+	case strings.HasPrefix(address, systemdScheme):
+		// Inherited sockets are only known at startup.
+	default:
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseSocketMode parses the octal file mode of unix sockets, eg. "0660".
+func parseSocketMode(mode string) (fs.FileMode, error) {
+	perm, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || perm > uint64(fs.ModePerm) {
+		return 0, fmt.Errorf("invalid file mode %q, expected octal permissions, eg. %s", mode, DefaultUnixSocketMode)
+	}
+
+	return fs.FileMode(perm), nil
+}
+
+// listenOn listens on a listen address, see validateAddress. Unix sockets are created with socketMode,
+// or DefaultUnixSocketMode when empty.
+func listenOn(address, socketMode string) (net.Listener, error) {
+	switch {
+	case strings.HasPrefix(address, unixScheme):
+		return listenUnix(strings.TrimPrefix(address, unixScheme), socketMode)
+	case strings.HasPrefix(address, systemdScheme):
+		return systemdListener(strings.TrimPrefix(address, systemdScheme))
+	default:
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+		}
+
+		return listener, nil
+	}
+}
+
+// listenUnix listens on a unix socket, replacing the socket file left over by a server
+// that did not stop cleanly. The socket file is removed when the listener is closed.
+func listenUnix(path, socketMode string) (net.Listener, error) {
+	mode, err := parseSocketMode(cmp.Or(socketMode, DefaultUnixSocketMode))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on unix socket %s: %w", path, err)
+	}
+
+	if err := os.Chmod(path, mode); err != nil {
+		_ = listener.Close()
+
+		return nil, fmt.Errorf("failed to set the mode of unix socket %s: %w", path, err)
+	}
+
+	return listener, nil
+}
+
+// removeStaleSocket removes the socket file at path unless a server still accepts connections on it.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check unix socket %s: %w", path, err)
+	}
+
+	if info.Mode().Type() != fs.ModeSocket {
+		return fmt.Errorf("failed to listen on unix socket %s: the file exists and is not a socket", path)
+	}
+
+	if conn, err := net.DialTimeout("unix", path, staleSocketTimeout); err == nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("failed to listen on unix socket %s: the socket is in use", path)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove stale unix socket %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// systemdListeners are the listeners passed by systemd socket activation, by name.
+// They are read from the environment once, since each can only be served once.
+var systemdListeners = struct {
+	once      sync.Once
+	listeners map[string]net.Listener
+	err       error
+}{}
+
+// systemdListener returns the inherited listener of a systemd socket by its name, or the single
+// inherited listener when name is empty.
+func systemdListener(name string) (net.Listener, error) {
+	systemdListeners.once.Do(func() {
+		systemdListeners.listeners, systemdListeners.err = inheritListeners(
+			os.Getenv("LISTEN_PID"), os.Getenv("LISTEN_FDS"), os.Getenv("LISTEN_FDNAMES"), systemdFirstFD)
+
+		// The sockets are not passed on to child processes.
+		_ = os.Unsetenv("LISTEN_PID")
+		_ = os.Unsetenv("LISTEN_FDS")
+		_ = os.Unsetenv("LISTEN_FDNAMES")
+	})
+
+	if systemdListeners.err != nil {
+		return nil, systemdListeners.err
+	}
+
+	if name == "" && len(systemdListeners.listeners) == 1 {
+		for single := range systemdListeners.listeners {
+			name = single
+		}
+	}
+
+	listener, ok := systemdListeners.listeners[name]
+	if !ok {
+		return nil, fmt.Errorf("no systemd socket named %q, %d sockets were passed", name, len(systemdListeners.listeners))
+	}
+
+	delete(systemdListeners.listeners, name)
+
+	return listener, nil
+}
+
+// inheritListeners returns the listeners of the file descriptors passed by systemd, as described
+// by the LISTEN_PID, LISTEN_FDS and LISTEN_FDNAMES environment variables. Sockets without a name
+// are named after their file descriptor, eg. "3".
+func inheritListeners(pid, count, names string, firstFD int) (map[string]net.Listener, error) {
+	if pid == "" || count == "" {
+		return nil, errors.New("no sockets were passed by systemd")
+	}
+
+	if pid != strconv.Itoa(os.Getpid()) {
+		return nil, fmt.Errorf("the systemd sockets were passed to process %s", pid)
+	}
+
+	fds, err := strconv.Atoi(count)
+	if err != nil || fds < 1 {
+		return nil, fmt.Errorf("invalid number of systemd sockets %q", count)
+	}
+
+	fdNames := strings.Split(names, ":")
+	listeners := make(map[string]net.Listener, fds)
+
+	for i := range fds {
+		fd := firstFD + i
+
+		name := strconv.Itoa(fd)
+		if i < len(fdNames) && fdNames[i] != "" && fdNames[i] != "unknown" {
+			name = fdNames[i]
+		}
+
+		syscall.CloseOnExec(fd)
+
+		file := os.NewFile(uintptr(fd), name)
+
+		// The listener holds its own copy of the file descriptor.
+		listener, err := net.FileListener(file)
+		_ = file.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to inherit systemd socket %s: %w", name, err)
+		}
+
+		listeners[name] = listener
+	}
+
+	return listeners, nil
+}
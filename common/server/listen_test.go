@@ -0,0 +1,166 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+func TestServerListensOnUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.sock")
+	cfg := Config{ListenAddress: unixScheme + path, UnixSocketMode: "0600"}
+
+	server, err := NewServer(t.Context(), cfg, Service{
+		Name:     "test.v1.TestService",
+		Register: func(grpc.ServiceRegistrar) {},
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+
+	if err := server.Start(Listeners{}); err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("failed to stat socket: %v", err)
+	}
+
+	if got := info.Mode().Perm(); got != 0o600 {
+		t.Errorf("got socket mode %o, want 600", got)
+	}
+
+	if err := Probe(t.Context(), cfg, ""); err != nil {
+		t.Errorf("failed to probe server on unix socket: %v", err)
+	}
+
+	server.Close()
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the socket to be removed once the server is stopped, got %v", err)
+	}
+}
+
+func TestServerServesOnListeners(t *testing.T) {
+	var listeners [3]net.Listener
+
+	for i := range listeners {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("failed to listen: %v", err)
+		}
+
+		listeners[i] = listener
+	}
+
+	// The listen addresses enable the gateway and the metrics, but are not listened on.
+	cfg := Config{ListenAddress: "127.0.0.1:1", HTTPListenAddress: "127.0.0.1:1", MetricsListenAddress: "127.0.0.1:1"}
+
+	server, err := NewServer(t.Context(), cfg, Service{
+		Name:     "test.v1.TestService",
+		Register: func(grpc.ServiceRegistrar) {},
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	defer server.Close()
+
+	if err := server.Start(Listeners{GRPC: listeners[0], HTTP: listeners[1], Metrics: listeners[2]}); err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+
+	if err := Probe(t.Context(), Config{ListenAddress: listeners[0].Addr().String()}, ""); err != nil {
+		t.Errorf("failed to probe server on its listener: %v", err)
+	}
+
+	for _, url := range []string{"http://" + listeners[1].Addr().String() + "/", "http://" + listeners[2].Addr().String() + metricsPath} {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Errorf("failed to get %s: %v", url, err)
+
+			continue
+		}
+
+		_ = resp.Body.Close()
+	}
+}
+
+func TestListenOnUnixSocketReplacesStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.sock")
+
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	// A server that did not stop cleanly leaves its socket behind.
+	stale.SetUnlinkOnClose(false)
+	_ = stale.Close()
+
+	listener, err := listenOn(unixScheme+path, "")
+	if err != nil {
+		t.Fatalf("failed to listen on stale socket: %v", err)
+	}
+	defer listener.Close()
+
+	// A socket in use is not replaced.
+	if _, err := listenOn(unixScheme+path, ""); err == nil {
+		t.Error("expected listening on a socket in use to fail")
+	}
+}
+
+func TestInheritListeners(t *testing.T) {
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer tcpListener.Close()
+
+	file, err := tcpListener.(*net.TCPListener).File()
+	if err != nil {
+		t.Fatalf("failed to get listener file: %v", err)
+	}
+
+	// The file descriptor is a copy of the listener, as systemd would pass it. It is owned, and
+	// closed, by the inherited listener rather than by file.
+	fd, err := syscall.Dup(int(file.Fd()))
+	_ = file.Close()
+
+	if err != nil {
+		t.Fatalf("failed to duplicate listener file: %v", err)
+	}
+
+	if _, err := inheritListeners("1", "1", "grpc", fd); err == nil {
+		t.Error("expected sockets passed to another process to be rejected")
+	}
+
+	listeners, err := inheritListeners(strconv.Itoa(os.Getpid()), "1", "grpc", fd)
+	if err != nil {
+		t.Fatalf("failed to inherit listeners: %v", err)
+	}
+
+	listener, ok := listeners["grpc"]
+	if !ok {
+		t.Fatalf("got listeners %v, want a grpc listener", listeners)
+	}
+	defer listener.Close()
+
+	if listener.Addr().String() != tcpListener.Addr().String() {
+		t.Errorf("got inherited address %s, want %s", listener.Addr(), tcpListener.Addr())
+	}
+}
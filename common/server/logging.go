@@ -0,0 +1,227 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/agntcy/oasf-sdk/common/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// healthMethodPrefix is the prefix of the health check methods, which are only logged at debug level
+// since they are called periodically by probes.
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// forwardedForHeader is the metadata the gateway sets to the address of HTTP clients.
+const forwardedForHeader = "x-forwarded-for"
+
+// LoggingConfig holds the logging options of a server.
+type LoggingConfig struct {
+	// Level is the minimum level of logged messages. Request payloads are logged at debug level.
+	Level slog.Level `json:"level" mapstructure:"level"`
+
+	// RedactFields are redacted from logged payloads, in addition to the default fields and to the
+	// fields with a sensitive name, eg. "password" or "client_secret".
+	RedactFields []string `json:"redact_fields,omitempty" mapstructure:"redact_fields"`
+}
+
+// requestLogger logs the requests handled by a gRPC server.
+type requestLogger struct {
+	redactor *redactor
+}
+
+func newRequestLogger(cfg LoggingConfig) *requestLogger {
+	return &requestLogger{redactor: newRedactor(cfg.RedactFields)}
+}
+
+func (l *requestLogger) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		l.logPayload(ctx, info.FullMethod, "Request payload", req)
+
+		resp, err := handler(ctx, req)
+
+		attrs := []any{"duration", time.Since(start)}
+		if msg, ok := req.(proto.Message); ok {
+			attrs = append(attrs, recordAttrs(msg)...)
+		}
+
+		l.logRequest(ctx, info.FullMethod, err, attrs...)
+
+		if err == nil {
+			l.logPayload(ctx, info.FullMethod, "Response payload", resp)
+		}
+
+		return resp, err
+	}
+}
+
+func (l *requestLogger) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		logged := &loggedStream{ServerStream: stream, logger: l, method: info.FullMethod}
+		err := handler(srv, logged)
+
+		l.logRequest(stream.Context(), info.FullMethod, err,
+			"duration", time.Since(start),
+			"received", logged.received.Load(),
+			"sent", logged.sent.Load(),
+		)
+
+		return err
+	}
+}
+
+// logRequest logs a handled request, at warning level when it failed on the server side.
+func (l *requestLogger) logRequest(ctx context.Context, method string, err error, attrs ...any) {
+	code := status.Code(err)
+
+	attrs = append([]any{
+		"method", method,
+		"peer", peerAddress(ctx),
+		"principal", auth.Principal(ctx),
+		"code", code.String(),
+	}, attrs...)
+
+	level := slog.LevelInfo
+
+	switch {
+	case strings.HasPrefix(method, healthMethodPrefix):
+		level = slog.LevelDebug
+	case serverError(code):
+		level = slog.LevelWarn
+	}
+
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	}
+
+	slog.Log(ctx, level, "Handled request", attrs...)
+}
+
+// logPayload logs a redacted request or response message at debug level.
+func (l *requestLogger) logPayload(ctx context.Context, method, msg string, payload any) {
+	if !slog.Default().Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	message, ok := payload.(proto.Message)
+	if !ok {
+		return
+	}
+
+	slog.Debug(msg, "method", method, "payload", l.redactor.message(message))
+}
+
+// serverError reports whether a status code is a failure of the server rather than of the request.
+func serverError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordAttrs returns the name and version of the record of a request, if any.
+func recordAttrs(msg proto.Message) []any {
+	message := msg.ProtoReflect()
+
+	field := message.Descriptor().Fields().ByName("record")
+	if field == nil || field.Kind() != protoreflect.MessageKind || !message.Has(field) {
+		return nil
+	}
+
+	record := message.Get(field).Message()
+
+	var attrs []any
+
+	for _, name := range []protoreflect.Name{"name", "version"} {
+		field := record.Descriptor().Fields().ByName(name)
+		if field != nil && field.Kind() == protoreflect.StringKind {
+			attrs = append(attrs, "record_"+string(name), record.Get(field).String())
+		}
+	}
+
+	return attrs
+}
+
+// peerAddress returns the address of the client of a request, or of the HTTP client
+// for requests forwarded by the gateway.
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if p.Addr.Network() == gatewayNetwork {
+		if client := forwardedClient(metadata.ValueFromIncomingContext(ctx, forwardedForHeader)); client != "" {
+			return client
+		}
+	}
+
+	return p.Addr.String()
+}
+
+// forwardedClient returns the address of the HTTP client of a request forwarded by the gateway.
+// The gateway appends the address of the HTTP peer to the addresses listed by the client, which
+// cannot be trusted, so the last address is used. Clients of the gateway behind a reverse proxy
+// have the address of the proxy, unless it connects over a unix socket and sets X-Forwarded-For.
+func forwardedClient(forwardedFor []string) string {
+	if len(forwardedFor) == 0 {
+		return ""
+	}
+
+	addresses := forwardedFor[len(forwardedFor)-1]
+	if i := strings.LastIndex(addresses, ","); i >= 0 {
+		addresses = addresses[i+1:]
+	}
+
+	return strings.TrimSpace(addresses)
+}
+
+// loggedStream counts the messages of a stream and logs their redacted payload at debug level.
+type loggedStream struct {
+	grpc.ServerStream
+
+	logger   *requestLogger
+	method   string
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+func (s *loggedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.received.Add(1)
+	s.logger.logPayload(s.Context(), s.method, "Received stream message", m)
+
+	return nil
+}
+
+func (s *loggedStream) SendMsg(m any) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	s.sent.Add(1)
+	s.logger.logPayload(s.Context(), s.method, "Sent stream message", m)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsPath              = "/metrics"
+	metricsReadHeaderTimeout = 10 * time.Second
+	metricsShutdownTimeout   = 10 * time.Second
+)
+
+// serverMetrics counts the requests handled by every server of the process, by method and status code.
+var serverMetrics = grpcprom.NewServerMetrics(
+	grpcprom.WithServerHandlingTimeHistogram(),
+)
+
+func init() {
+	prometheus.MustRegister(serverMetrics)
+}
+
+// metricsServer serves the metrics of the default Prometheus registry, which holds the
+// gRPC server metrics along with the metrics of the services.
+type metricsServer struct {
+	httpServer *http.Server
+}
+
+func newMetricsServer(address string) *metricsServer {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.Handler())
+
+	return &metricsServer{
+		httpServer: &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: metricsReadHeaderTimeout,
+		},
+	}
+}
+
+// start serves the metrics on listen, or on its address when nil, reporting the error of its goroutine to errs.
+func (m *metricsServer) start(listen net.Listener, socketMode string, errs chan<- error) error {
+	if listen == nil {
+		var err error
+		if listen, err = listenOn(m.httpServer.Addr, socketMode); err != nil {
+			return err
+		}
+	}
+
+	go func() {
+		slog.Info("Starting metrics server", "address", m.httpServer.Addr)
+
+		if err := m.httpServer.Serve(listen); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errs <- fmt.Errorf("metrics server: %w", err)
+		}
+	}()
+
+	return nil
+}
+
+func (m *metricsServer) close() {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+	defer cancel()
+
+	if err := m.httpServer.Shutdown(ctx); err != nil {
+		slog.Error("Failed to stop metrics server", "error", err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "[REDACTED]"
+
+// defaultRedactFields are the fields that are always redacted, along with their whole value:
+// the environment and headers of MCP servers, and the API keys of model extensions.
+var defaultRedactFields = []string{"env", "headers", "api_key", "authorization"}
+
+// sensitiveNameParts flag the fields that are redacted by name, eg. "client_secret" or "accessToken".
+var sensitiveNameParts = []string{"secret", "password", "passwd", "token", "apikey", "credential", "privatekey"}
+
+// redactor redacts the sensitive fields of logged messages.
+type redactor struct {
+	fields map[string]bool
+}
+
+// newRedactor creates a redactor of the default fields, the given fields, and the fields
+// with a sensitive name.
+func newRedactor(fields []string) *redactor {
+	r := &redactor{fields: make(map[string]bool)}
+
+	for _, field := range slices.Concat(defaultRedactFields, fields) {
+		r.fields[normalizeFieldName(field)] = true
+	}
+
+	return r
+}
+
+// message returns a loggable copy of msg with its sensitive fields redacted.
+// Bytes fields, eg. raw documents, are left out since their content cannot be redacted.
+func (r *redactor) message(msg proto.Message) any {
+	msg = proto.Clone(msg)
+	clearBytes(msg.ProtoReflect())
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return redacted
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return redacted
+	}
+
+	return r.value(value)
+}
+
+func (r *redactor) value(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if r.sensitive(key) {
+				v[key] = redacted
+			} else {
+				v[key] = r.value(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = r.value(item)
+		}
+	}
+
+	return value
+}
+
+func (r *redactor) sensitive(name string) bool {
+	name = normalizeFieldName(name)
+	if r.fields[name] {
+		return true
+	}
+
+	for _, part := range sensitiveNameParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeFieldName matches field names regardless of their case and separators,
+// eg. "api_key", "apiKey" and "API-Key".
+func normalizeFieldName(name string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+}
+
+// clearBytes clears the bytes fields of msg and of its nested messages.
+func clearBytes(msg protoreflect.Message) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.Kind() == protoreflect.BytesKind:
+			msg.Clear(field)
+		case field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind:
+			// Scalars have nothing to clear.
+		case field.IsList():
+			for i := range value.List().Len() {
+				clearBytes(value.List().Get(i).Message())
+			}
+		case field.IsMap():
+			if field.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+					clearBytes(value.Message())
+
+					return true
+				})
+			}
+		default:
+			clearBytes(value.Message())
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestRedactorMessage(t *testing.T) {
+	msg, err := structpb.NewStruct(map[string]any{
+		"name": "poc/example-agent",
+		"extensions": []any{
+			map[string]any{
+				"name": "schema.oasf.agntcy.org/features/runtime/mcp",
+				"data": map[string]any{
+					"servers": map[string]any{
+						"github": map[string]any{
+							"command": "docker",
+							"env":     map[string]any{"GITHUB_PERSONAL_ACCESS_TOKEN": "ghp_leaked"},
+							"headers": map[string]any{"X-Custom": "leaked-header"},
+						},
+					},
+				},
+			},
+			map[string]any{
+				"name": "schema.oasf.agntcy.org/features/runtime/model",
+				"data": map[string]any{
+					"llm_model": map[string]any{
+						"model":   "gpt-4o",
+						"api_key": "sk-leaked",
+					},
+					"clientSecret": "leaked-secret",
+					"tenant":       "leaked-tenant",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create message: %v", err)
+	}
+
+	data, err := json.Marshal(newRedactor([]string{"tenant"}).message(msg))
+	if err != nil {
+		t.Fatalf("failed to marshal redacted message: %v", err)
+	}
+
+	logged := string(data)
+
+	for _, leaked := range []string{"ghp_leaked", "leaked-header", "sk-leaked", "leaked-secret", "leaked-tenant"} {
+		if strings.Contains(logged, leaked) {
+			t.Errorf("redacted message contains %q: %s", leaked, logged)
+		}
+	}
+
+	for _, kept := range []string{"poc/example-agent", "docker", "gpt-4o"} {
+		if !strings.Contains(logged, kept) {
+			t.Errorf("redacted message does not contain %q: %s", kept, logged)
+		}
+	}
+
+	// The original message is not modified.
+	if got := msg.Fields["name"].GetStringValue(); got != "poc/example-agent" {
+		t.Errorf("got name %q after redaction", got)
+	}
+}
+
+func TestRedactorMessageOmitsBytes(t *testing.T) {
+	msg := wrapperspb.Bytes([]byte(`{"api_key": "sk-leaked"}`))
+
+	data, err := json.Marshal(newRedactor(nil).message(msg))
+	if err != nil {
+		t.Fatalf("failed to marshal redacted message: %v", err)
+	}
+
+	// The value of a BytesValue is its only field.
+	if string(data) != `""` {
+		t.Errorf("got redacted message %s, want an empty value", data)
+	}
+
+	if len(msg.GetValue()) == 0 {
+		t.Error("original message was cleared")
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package server serves any set of SDK services on a single gRPC listener,
+// along with their HTTP/JSON gateway.
+package server
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/agntcy/oasf-sdk/common/auth"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Service is a gRPC service that can be served by a Server.
+type Service struct {
+	// Name is the full name of the gRPC service, eg. "validation.v1.ValidationService".
+	Name string
+
+	// Register registers the service implementation on the gRPC server.
+	Register func(grpc.ServiceRegistrar)
+
+	// RegisterGateway registers the HTTP/JSON handlers of the service, if any.
+	// The handlers forward requests to the gRPC server through conn.
+	RegisterGateway func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+	// OpenAPIPath is the HTTP path the OpenAPI document of the service is served at.
+	OpenAPIPath string
+
+	// OpenAPI is the OpenAPI document of the HTTP/JSON API of the service, if any.
+	OpenAPI []byte
+}
+
+// servingGoroutines is the number of goroutines serving a server: the gRPC server, the in-memory
+// connection and the HTTP server of the gateway, and the metrics server.
+const servingGoroutines = 4
+
+type Server struct {
+	cfg          Config
+	services     []Service
+	grpcServer   *grpc.Server
+	healthServer *health.Server
+	gateway      *gateway
+	metrics      *metricsServer
+	listener     net.Listener
+
+	// errs receives the errors of the goroutines that stopped serving before the server was closed.
+	errs chan error
+}
+
+// Run serves the services until the context is canceled or the process is signaled to stop.
+func Run(ctx context.Context, cfg Config, services ...Service) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	slog.SetLogLoggerLevel(cfg.Logging.Level)
+
+	server, err := NewServer(ctx, cfg, services...)
+	if err != nil {
+		return fmt.Errorf("failed to create server: %w", err)
+	}
+
+	// Whatever was started is stopped when the server fails to start.
+	defer server.Close()
+
+	if err := server.Start(Listeners{}); err != nil {
+		return fmt.Errorf("failed to start server: %w", err)
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("stopping server due to context cancellation: %w", ctx.Err())
+	case sig := <-sigCh:
+		return fmt.Errorf("stopping server due to signal: %v", sig)
+	case err := <-server.errs:
+		return fmt.Errorf("server stopped unexpectedly: %w", err)
+	}
+}
+
+func NewServer(ctx context.Context, cfg Config, services ...Service) (*Server, error) {
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no services to serve")
+	}
+
+	names := make([]string, 0, len(services))
+	for _, service := range services {
+		names = append(names, service.Name)
+	}
+
+	slog.Info("Creating new server", "config", cfg, "services", names)
+
+	opts := append(cfg.Limits.serverOptions(),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(serverMetrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(serverMetrics.StreamServerInterceptor()),
+	)
+
+	// Requests are limited by peer before they are authenticated, so that requests with
+	// invalid credentials cannot flood the authentication.
+	peerLimiter := newPeerLimiter(cfg.Limits)
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(peerLimiter.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(peerLimiter.StreamServerInterceptor()),
+	)
+
+	// Requests are authenticated after they are counted, so that rejected requests are
+	// reported in the server metrics.
+	if cfg.Auth.Enabled() {
+		interceptor, err := auth.NewInterceptor(cfg.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up authentication: %w", err)
+		}
+
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(interceptor.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(interceptor.StreamServerInterceptor()),
+		)
+	}
+
+	// Requests are logged once authenticated, along with their principal.
+	// Rejected requests are logged by the authentication interceptor.
+	requestLogger := newRequestLogger(cfg.Logging)
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(requestLogger.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(requestLogger.StreamServerInterceptor()),
+	)
+
+	// Requests are rate limited once authenticated, so that clients are limited by principal,
+	// and once logged, so that rejected requests are logged.
+	limiter := newLimiter(cfg.Limits)
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(limiter.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(limiter.StreamServerInterceptor()),
+	)
+
+	// The gateway serves HTTPS with the certificates of the gRPC server.
+	var gatewayTLSConfig *tls.Config
+	if cfg.TLS.Enabled() {
+		reloader, err := newCertReloader(cfg.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificates: %w", err)
+		}
+
+		opts = append(opts, grpc.Creds(serverCredentials{
+			TransportCredentials: credentials.NewTLS(reloader.serverConfig("h2")),
+		}))
+		gatewayTLSConfig = reloader.serverConfig("h2", "http/1.1")
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+
+	server := &Server{
+		cfg:          cfg,
+		services:     services,
+		grpcServer:   grpcServer,
+		healthServer: health.NewServer(),
+		errs:         make(chan error, servingGoroutines),
+	}
+
+	// Services are not serving until the server is listening. Services are created
+	// before they are registered, eg. the validation schemas are compiled, so they are
+	// ready to handle requests once the server starts.
+	server.healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	for _, service := range services {
+		service.Register(server.grpcServer)
+		server.healthServer.SetServingStatus(service.Name, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	healthpb.RegisterHealthServer(server.grpcServer, server.healthServer)
+	reflection.Register(server.grpcServer)
+
+	// Report every method with zero counts until it is first called.
+	serverMetrics.InitializeMetrics(server.grpcServer)
+
+	if cfg.HTTPListenAddress != "" {
+		gateway, err := newGateway(ctx, cfg.HTTPListenAddress, gatewayTLSConfig, cfg.Limits, services)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP gateway: %w", err)
+		}
+
+		server.gateway = gateway
+	}
+
+	if cfg.MetricsListenAddress != "" {
+		server.metrics = newMetricsServer(cfg.MetricsListenAddress)
+	}
+
+	return server, nil
+}
+
+// Close stops the server. In-flight requests are drained for up to the shutdown timeout,
+// after which the remaining requests are canceled, eg. long-lived streams.
+func (s *Server) Close() {
+	// Report every service as not serving while in-flight requests are drained.
+	s.healthServer.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cmp.Or(s.cfg.ShutdownTimeout, DefaultShutdownTimeout))
+	defer cancel()
+
+	// The gateway forwards its requests to the gRPC server, so both are drained together.
+	gatewayClosed := make(chan struct{})
+	go func() {
+		defer close(gatewayClosed)
+
+		if s.gateway != nil {
+			s.gateway.close(ctx)
+		}
+	}()
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		s.grpcServer.GracefulStop()
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Warn("Shutdown timeout exceeded, canceling the remaining requests")
+		s.grpcServer.Stop()
+		<-stopped
+	}
+
+	<-gatewayClosed
+
+	// Metrics are served until the end, so that the drain can be monitored.
+	if s.metrics != nil {
+		s.metrics.close()
+	}
+}
+
+// Listeners are listeners opened by the caller of Start, eg. on ephemeral ports in tests,
+// which are served in place of the listen addresses of the configuration.
+type Listeners struct {
+	GRPC    net.Listener
+	HTTP    net.Listener
+	Metrics net.Listener
+}
+
+// Start serves the server on listeners, or on the listen addresses of the configuration for the
+// listeners that are nil. The HTTP and metrics listeners are only served when their listen address
+// is set. The server is stopped with Close, also when it fails to start.
+func (s *Server) Start(listeners Listeners) error {
+	listener := listeners.GRPC
+	if listener == nil {
+		var err error
+		if listener, err = listenOn(s.cfg.ListenAddress, s.cfg.UnixSocketMode); err != nil {
+			return err
+		}
+	}
+
+	s.listener = listener
+
+	go func() {
+		slog.Info("Starting server", "address", s.cfg.ListenAddress)
+
+		if err := s.grpcServer.Serve(listener); err != nil {
+			s.errs <- fmt.Errorf("gRPC server: %w", err)
+		}
+	}()
+
+	if s.gateway != nil {
+		if err := s.gateway.start(s.grpcServer, listeners.HTTP, s.cfg.UnixSocketMode, s.errs); err != nil {
+			return fmt.Errorf("failed to start HTTP gateway: %w", err)
+		}
+	}
+
+	if s.metrics != nil {
+		if err := s.metrics.start(listeners.Metrics, s.cfg.UnixSocketMode, s.errs); err != nil {
+			return fmt.Errorf("failed to start metrics server: %w", err)
+		}
+	}
+
+	for _, service := range s.services {
+		s.healthServer.SetServingStatus(service.Name, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	s.healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agntcy/oasf-sdk/common/address"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// testCheckMethod is the method of the test service, which answers like a health check but is
+// not exempt from authentication and rate limits.
+const testCheckMethod = "/test.v1.TestService/Check"
+
+var testServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.v1.TestService",
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Check",
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			req := &healthpb.HealthCheckRequest{}
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+
+			handler := func(context.Context, any) (any, error) {
+				return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+			}
+
+			return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: testCheckMethod}, handler)
+		},
+	}},
+}
+
+func registerTestService(registrar grpc.ServiceRegistrar) {
+	registrar.RegisterService(&testServiceDesc, struct{}{})
+}
+
+// startTestServer starts a server with cfg on a unix socket and returns a client connection to it.
+func startTestServer(t *testing.T, cfg Config) (*Server, *grpc.ClientConn) {
+	t.Helper()
+
+	cfg.ListenAddress = unixScheme + filepath.Join(t.TempDir(), "server.sock")
+
+	server, err := NewServer(t.Context(), cfg, Service{
+		Name:     "test.v1.TestService",
+		Register: registerTestService,
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+
+	if err := server.Start(Listeners{}); err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+
+	target, err := address.ClientTarget(cfg.ListenAddress)
+	if err != nil {
+		t.Fatalf("failed to get client target: %v", err)
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return server, conn
+}
+
+// watchHealth opens a health watch, a stream that stays open until it is canceled,
+// and waits for the server to be reported as serving.
+func watchHealth(ctx context.Context, t *testing.T, conn *grpc.ClientConn) grpc.ServerStreamingClient[healthpb.HealthCheckResponse] {
+	t.Helper()
+
+	stream, err := healthpb.NewHealthClient(conn).Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("failed to watch health: %v", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("failed to receive health status: %v", err)
+	}
+
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("got status %s, want SERVING", resp.GetStatus())
+	}
+
+	return stream
+}
+
+func TestCloseCancelsActiveStreamsAfterShutdownTimeout(t *testing.T) {
+	const shutdownTimeout = 200 * time.Millisecond
+
+	server, conn := startTestServer(t, Config{ShutdownTimeout: shutdownTimeout})
+	stream := watchHealth(t.Context(), t, conn)
+
+	start := time.Now()
+	closed := make(chan time.Duration)
+
+	go func() {
+		server.Close()
+		closed <- time.Since(start)
+	}()
+
+	// The stream is told that the server is not serving while it is drained.
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("failed to receive health status during drain: %v", err)
+	}
+
+	if resp.GetStatus() != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("got status %s during drain, want NOT_SERVING", resp.GetStatus())
+	}
+
+	// The stream is canceled once the shutdown timeout is exceeded.
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("got error %v after the shutdown timeout, want Unavailable", err)
+	}
+
+	select {
+	case elapsed := <-closed:
+		if elapsed < shutdownTimeout {
+			t.Errorf("server closed after %v, before the shutdown timeout", elapsed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not close after the shutdown timeout")
+	}
+}
+
+func TestCloseDrainsStreamsThatComplete(t *testing.T) {
+	server, conn := startTestServer(t, Config{ShutdownTimeout: time.Minute})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	stream := watchHealth(ctx, t, conn)
+
+	closed := make(chan struct{})
+
+	go func() {
+		server.Close()
+		close(closed)
+	}()
+
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("failed to receive health status during drain: %v", err)
+	}
+
+	// The client ends its stream once told that the server is not serving.
+	cancel()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not close once its streams completed")
+	}
+}
+
+func TestServeErrorsAreReported(t *testing.T) {
+	server, _ := startTestServer(t, Config{})
+	t.Cleanup(server.Close)
+
+	// The listener fails under the server, eg. when its socket is closed.
+	_ = server.listener.Close()
+
+	select {
+	case err := <-server.errs:
+		if err == nil {
+			t.Error("got a nil serve error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve error was not reported")
+	}
+}
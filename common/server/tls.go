@@ -0,0 +1,216 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// tlsReloadInterval is how often certificate files are checked for changes, at most.
+const tlsReloadInterval = 10 * time.Second
+
+// TLSConfig configures TLS for the gRPC server and its HTTP gateway.
+type TLSConfig struct {
+	// CertFile is the PEM encoded certificate chain of the server. TLS is disabled when empty.
+	CertFile string `json:"cert_file,omitempty" mapstructure:"cert_file"`
+
+	// KeyFile is the PEM encoded private key of the server.
+	KeyFile string `json:"key_file,omitempty" mapstructure:"key_file"`
+
+	// ClientCAFile is a PEM bundle of the CAs client certificates are verified against.
+	// When set, clients must present a valid certificate.
+	ClientCAFile string `json:"client_ca_file,omitempty" mapstructure:"client_ca_file"`
+}
+
+// Enabled reports whether TLS is configured.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+// certReloader serves the certificates of a TLSConfig, reloading them when their files change,
+// eg. when they are rotated by cert-manager. Failed reloads keep the previous certificates.
+type certReloader struct {
+	cfg      TLSConfig
+	interval time.Duration
+
+	mu        sync.Mutex
+	checked   time.Time
+	stamps    map[string][sha256.Size]byte
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func newCertReloader(cfg TLSConfig) (*certReloader, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("both a certificate and a key file are required")
+	}
+
+	reloader := &certReloader{
+		cfg:      cfg,
+		interval: tlsReloadInterval,
+	}
+
+	stamps, err := reloader.stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := reloader.load(stamps); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// serverConfig returns a TLS configuration that serves the current certificates
+// and negotiates one of the given application protocols.
+func (r *certReloader) serverConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.reloadIfChanged()
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+
+			if r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return config, nil
+		},
+	}
+}
+
+func (r *certReloader) reloadIfChanged() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checked) < r.interval {
+		return
+	}
+
+	r.checked = time.Now()
+
+	stamps, err := r.stat()
+	if err != nil {
+		slog.Error("Failed to check TLS certificates", "error", err)
+
+		return
+	}
+
+	if r.unchanged(stamps) {
+		return
+	}
+
+	if err := r.load(stamps); err != nil {
+		slog.Error("Failed to reload TLS certificates, keeping the previous ones", "error", err)
+
+		return
+	}
+
+	slog.Info("Reloaded TLS certificates", "cert_file", r.cfg.CertFile)
+}
+
+func (r *certReloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+
+	return files
+}
+
+// stat returns the hash of every file. Modification times are not used, as they can be
+// too coarse to notice files that are rewritten in quick succession.
+func (r *certReloader) stat() (map[string][sha256.Size]byte, error) {
+	stamps := make(map[string][sha256.Size]byte)
+
+	for _, file := range r.files() {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		stamps[file] = sha256.Sum256(data)
+	}
+
+	return stamps, nil
+}
+
+func (r *certReloader) unchanged(stamps map[string][sha256.Size]byte) bool {
+	for file, stamp := range stamps {
+		if r.stamps[file] != stamp {
+			return false
+		}
+	}
+
+	return true
+}
+
+// load reads the certificates. The stamps are only recorded once they load, so that
+// files that are being rotated one at a time are loaded again on the next check.
+func (r *certReloader) load(stamps map[string][sha256.Size]byte) error {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.cfg.ClientCAFile != "" {
+		data, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA file: %w", err)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in client CA file %s", r.cfg.ClientCAFile)
+		}
+	}
+
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.stamps = stamps
+
+	return nil
+}
+
+// serverCredentials secures gRPC connections with TLS, except for the in-memory connection of
+// the HTTP gateway, whose requests are already secured by the TLS listener of the gateway.
+type serverCredentials struct {
+	credentials.TransportCredentials
+}
+
+func (c serverCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if conn.LocalAddr().Network() == gatewayNetwork {
+		return insecure.NewCredentials().ServerHandshake(conn)
+	}
+
+	return c.TransportCredentials.ServerHandshake(conn)
+}
+
+func (c serverCredentials) Clone() credentials.TransportCredentials {
+	return serverCredentials{TransportCredentials: c.TransportCredentials.Clone()}
+}
@@ -0,0 +1,314 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// testCA issues certificates for tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create CA certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse CA certificate: %v", err)
+	}
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns the PEM encoded certificate and key of a leaf certificate for localhost.
+func (ca *testCA) issue(t *testing.T, serial int64) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	return pool
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+// writeCertificate writes a certificate issued by ca to the files of cfg.
+func writeCertificate(t *testing.T, ca *testCA, cfg TLSConfig, serial int64) {
+	t.Helper()
+
+	cert, key := ca.issue(t, serial)
+	writeFile(t, cfg.CertFile, cert)
+	writeFile(t, cfg.KeyFile, key)
+}
+
+func newTestTLSConfig(t *testing.T) TLSConfig {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	return TLSConfig{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+	}
+}
+
+func TestCertReloaderServesRotatedCertificates(t *testing.T) {
+	ca := newTestCA(t)
+	cfg := newTestTLSConfig(t)
+	writeCertificate(t, ca, cfg, 2)
+
+	reloader, err := newCertReloader(cfg)
+	if err != nil {
+		t.Fatalf("failed to create reloader: %v", err)
+	}
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", reloader.serverConfig())
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	serial := func() int64 {
+		t.Helper()
+
+		conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{RootCAs: ca.pool(), ServerName: "localhost"})
+		if err != nil {
+			t.Fatalf("failed to connect: %v", err)
+		}
+		defer conn.Close()
+
+		return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+	}
+
+	if got := serial(); got != 2 {
+		t.Fatalf("expected certificate 2, got %d", got)
+	}
+
+	// Check the files on every handshake.
+	reloader.mu.Lock()
+	reloader.interval = 0
+	reloader.mu.Unlock()
+
+	writeCertificate(t, ca, cfg, 3)
+
+	if got := serial(); got != 3 {
+		t.Fatalf("expected rotated certificate 3, got %d", got)
+	}
+
+	writeFile(t, cfg.KeyFile, []byte("not a key"))
+
+	if got := serial(); got != 3 {
+		t.Fatalf("expected certificate 3 to be kept after a failed reload, got %d", got)
+	}
+}
+
+func TestServerRequiresClientCertificates(t *testing.T) {
+	ca := newTestCA(t)
+	cfg := newTestTLSConfig(t)
+	writeCertificate(t, ca, cfg, 2)
+
+	cfg.ClientCAFile = filepath.Join(filepath.Dir(cfg.CertFile), "ca.crt")
+	writeFile(t, cfg.ClientCAFile, ca.pem)
+
+	server, err := NewServer(t.Context(), Config{TLS: cfg}, Service{
+		Name:     "test.v1.TestService",
+		Register: func(grpc.ServiceRegistrar) {},
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	go func() {
+		_ = server.grpcServer.Serve(listener)
+	}()
+	t.Cleanup(server.grpcServer.Stop)
+
+	check := func(clientConfig *tls.Config) error {
+		t.Helper()
+
+		conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientConfig)))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		defer conn.Close()
+
+		_, err = healthpb.NewHealthClient(conn).Check(t.Context(), &healthpb.HealthCheckRequest{})
+
+		return err
+	}
+
+	if err := check(&tls.Config{RootCAs: ca.pool(), ServerName: "localhost"}); err == nil {
+		t.Error("expected clients without a certificate to be rejected")
+	}
+
+	clientCert, clientKey := ca.issue(t, 4)
+
+	cert, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		t.Fatalf("failed to load client certificate: %v", err)
+	}
+
+	if err := check(&tls.Config{RootCAs: ca.pool(), ServerName: "localhost", Certificates: []tls.Certificate{cert}}); err != nil {
+		t.Errorf("expected clients with a certificate to be accepted, got %v", err)
+	}
+}
+
+func TestProbeVerifiesServer(t *testing.T) {
+	ca := newTestCA(t)
+	cfg := newTestTLSConfig(t)
+	writeCertificate(t, ca, cfg, 2)
+
+	dir := filepath.Dir(cfg.CertFile)
+	cfg.ClientCAFile = filepath.Join(dir, "ca.crt")
+	writeFile(t, cfg.ClientCAFile, ca.pem)
+
+	otherCA := newTestCA(t)
+	otherCAFile := filepath.Join(dir, "other-ca.crt")
+	writeFile(t, otherCAFile, otherCA.pem)
+
+	probeCert, probeKey := ca.issue(t, 3)
+	probe := HealthProbeConfig{
+		CAFile:   cfg.ClientCAFile,
+		CertFile: filepath.Join(dir, "probe.crt"),
+		KeyFile:  filepath.Join(dir, "probe.key"),
+	}
+	writeFile(t, probe.CertFile, probeCert)
+	writeFile(t, probe.KeyFile, probeKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	server, err := NewServer(t.Context(), Config{ListenAddress: listener.Addr().String(), TLS: cfg}, Service{
+		Name:     "test.v1.TestService",
+		Register: func(grpc.ServiceRegistrar) {},
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	defer server.Close()
+
+	if err := server.Start(Listeners{GRPC: listener}); err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		probe   HealthProbeConfig
+		wantErr bool
+	}{
+		{name: "trusted server with client certificate", probe: probe},
+		{name: "without client certificate", probe: HealthProbeConfig{CAFile: probe.CAFile}, wantErr: true},
+		{name: "untrusted server", probe: HealthProbeConfig{CAFile: otherCAFile, CertFile: probe.CertFile, KeyFile: probe.KeyFile}, wantErr: true},
+		{name: "other server name", probe: HealthProbeConfig{CAFile: probe.CAFile, ServerName: "example.com", CertFile: probe.CertFile, KeyFile: probe.KeyFile}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Probe(t.Context(), Config{ListenAddress: listener.Addr().String(), TLS: cfg, HealthProbe: tt.probe}, "")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
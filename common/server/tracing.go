@@ -0,0 +1,93 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// TracingConfig configures the export of OpenTelemetry traces.
+type TracingConfig struct {
+	// OTLPEndpoint is the address of the OTLP/gRPC collector traces are exported to, eg. "otel-collector:4317".
+	// Traces are not exported when empty, but the incoming trace context is still propagated.
+	OTLPEndpoint string `json:"otlp_endpoint,omitempty" mapstructure:"otlp_endpoint"`
+
+	// OTLPInsecure disables TLS on the connection to the collector.
+	OTLPInsecure bool `json:"otlp_insecure,omitempty" mapstructure:"otlp_insecure"`
+
+	// SampleRatio is the ratio of new traces that are sampled. Traces started by a
+	// caller follow the sampling decision of the caller.
+	SampleRatio float64 `json:"sample_ratio,omitempty" mapstructure:"sample_ratio"`
+
+	// ServiceName is the name the server is reported with in traces.
+	ServiceName string `json:"service_name,omitempty" mapstructure:"service_name"`
+}
+
+// DefaultSampleRatio samples every new trace.
+const DefaultSampleRatio = 1.0
+
+const tracingShutdownTimeout = 10 * time.Second
+
+// SetupTracing installs the global OpenTelemetry propagator and, when an OTLP endpoint
+// is configured, a tracer provider that exports to it. It should be called before the
+// services are created, so that their startup is traced too.
+//
+// The returned function flushes and stops the export of traces.
+func SetupTracing(ctx context.Context, cfg TracingConfig) (func(), error) {
+	// Follow the W3C trace context of callers.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.OTLPEndpoint == "" {
+		return func() {}, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+	if cfg.OTLPInsecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	slog.Info("Exporting traces", "endpoint", cfg.OTLPEndpoint, "service_name", cfg.ServiceName)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		if err := provider.Shutdown(ctx); err != nil {
+			slog.Error("Failed to flush traces", "error", err)
+		}
+	}, nil
+}
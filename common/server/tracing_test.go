@@ -0,0 +1,93 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestServerTracesRequestsInCallerTrace(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	server, err := NewServer(t.Context(), Config{}, Service{
+		Name:     "test.v1.TestService",
+		Register: func(grpc.ServiceRegistrar) {},
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.grpcServer.Serve(listener)
+	}()
+	t.Cleanup(server.grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	// A trace started by a caller that is not traced by this process.
+	caller := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(t.Context(), caller)
+
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("failed to check health: %v", err)
+	}
+
+	var serverSpan *tracetest.SpanStub
+	for _, span := range exporter.GetSpans() {
+		if span.SpanKind == trace.SpanKindServer {
+			serverSpan = &span
+		}
+	}
+
+	if serverSpan == nil {
+		t.Fatalf("expected a server span, got %v", exporter.GetSpans())
+	}
+
+	if serverSpan.Name != "grpc.health.v1.Health/Check" {
+		t.Errorf("expected span grpc.health.v1.Health/Check, got %s", serverSpan.Name)
+	}
+
+	if serverSpan.SpanContext.TraceID() != caller.TraceID() {
+		t.Errorf("expected trace %s, got %s", caller.TraceID(), serverSpan.SpanContext.TraceID())
+	}
+}
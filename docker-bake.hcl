@@ -3,6 +3,7 @@
 
 group "default" {
   targets = [
+    "oasf",
     "translation",
     "validation",
   ]
@@ -27,6 +28,15 @@ target "_e2e" {
   ]
 }
 
+target "oasf" {
+  context = "."
+  dockerfile = "./cli/Dockerfile"
+  inherits = [
+    "_common",
+  ]
+  tags = ["oasf-sdk"]
+}
+
 target "translation" {
   context = "."
   dockerfile = "./translation/Dockerfile"
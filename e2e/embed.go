@@ -16,3 +16,9 @@ var invalidV060Record []byte
 
 //go:embed fixtures/translation_record.json
 var translationRecord []byte
+
+//go:embed fixtures/invalid_v0.6.0_record.yaml
+var invalidV060RecordYAML []byte
+
+//go:embed fixtures/record_schema.json
+var recordSchema []byte
@@ -0,0 +1,114 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"time"
+
+	translationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/translation/v1/translationv1grpc"
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	translationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// errorDetails returns the ErrorInfo and BadRequest details of a status error.
+func errorDetails(err error) (*errdetails.ErrorInfo, *errdetails.BadRequest) {
+	var (
+		info       *errdetails.ErrorInfo
+		badRequest *errdetails.BadRequest
+	)
+
+	for _, detail := range status.Convert(err).Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+
+	return info, badRequest
+}
+
+var _ = Describe("Error details E2E", func() {
+	validationConn, err := grpc.NewClient(validationServer.grpc, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+
+	translationConn, err := grpc.NewClient(translationServer.grpc, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+
+	validationClient := validationv1grpc.NewValidationServiceClient(validationConn)
+	translationClient := translationv1grpc.NewTranslationServiceClient(translationConn)
+
+	var record objectsv3.Record
+	Expect(protojson.Unmarshal(validV060Record, &record)).To(Succeed())
+
+	It("should report an unknown schema version as NotFound", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		unknownVersionRecord := objectsv3.Record{Name: record.Name, Version: record.Version, SchemaVersion: "v9.9.9"}
+
+		_, err := validationClient.ValidateRecord(ctx, &validationv1.ValidateRecordRequest{Record: &unknownVersionRecord})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		info, badRequest := errorDetails(err)
+		Expect(info).NotTo(BeNil())
+		Expect(info.Reason).To(Equal("SCHEMA_VERSION_NOT_FOUND"))
+		Expect(info.Domain).To(Equal("oasf-sdk.agntcy.org"))
+		Expect(info.Metadata).To(HaveKeyWithValue("schema_version", "v9.9.9"))
+		Expect(info.Metadata).To(HaveKey("available_versions"))
+
+		Expect(badRequest).NotTo(BeNil())
+		Expect(badRequest.FieldViolations).To(HaveLen(1))
+		Expect(badRequest.FieldViolations[0].Field).To(Equal("record.schema_version"))
+	})
+
+	It("should report a schema URL that is not an HTTP URL as InvalidArgument", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_, err := validationClient.ValidateRecord(ctx, &validationv1.ValidateRecordRequest{
+			Record:    &record,
+			SchemaUrl: "file:///etc/passwd",
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		info, badRequest := errorDetails(err)
+		Expect(info).NotTo(BeNil())
+		Expect(info.Reason).To(Equal("INVALID_SCHEMA_URL"))
+
+		Expect(badRequest).NotTo(BeNil())
+		Expect(badRequest.FieldViolations).To(HaveLen(1))
+		Expect(badRequest.FieldViolations[0].Field).To(Equal("schema_url"))
+	})
+
+	It("should report a record without the translated extension as InvalidArgument", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_, err := translationClient.RecordToA2A(ctx, &translationv1.RecordToA2ARequest{
+			Record: &objectsv3.Record{Name: "no-extensions"},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		info, badRequest := errorDetails(err)
+		Expect(info).NotTo(BeNil())
+		Expect(info.Reason).To(Equal("EXTENSION_NOT_FOUND"))
+		Expect(info.Metadata).To(HaveKeyWithValue("extension", "A2A"))
+
+		Expect(badRequest).NotTo(BeNil())
+		Expect(badRequest.FieldViolations[0].Field).To(Equal("record.extensions"))
+	})
+})
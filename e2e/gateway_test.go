@@ -0,0 +1,123 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTP Gateway E2E", func() {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	post := func(url, contentType string, body []byte) *http.Response {
+		resp, err := httpClient.Post(url, contentType, bytes.NewReader(body))
+		Expect(err).NotTo(HaveOccurred(), "HTTP request should not fail")
+		DeferCleanup(resp.Body.Close)
+
+		return resp
+	}
+
+	recordRequest := func(record []byte, fields map[string]any) []byte {
+		request := map[string]any{"record": json.RawMessage(record)}
+		for key, value := range fields {
+			request[key] = value
+		}
+
+		body, err := json.Marshal(request)
+		Expect(err).NotTo(HaveOccurred(), "Failed to encode request")
+
+		return body
+	}
+
+	Context("validation", func() {
+		baseURL := "http://" + validationServer.http
+
+		It("should validate a record", func() {
+			resp := post(baseURL+"/v1/records:validate", "application/json", recordRequest(validV060Record, nil))
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			var result struct {
+				IsValid       bool     `json:"is_valid"`
+				Errors        []string `json:"errors"`
+				SchemaVersion string   `json:"schema_version"`
+			}
+			Expect(json.NewDecoder(resp.Body).Decode(&result)).To(Succeed())
+			Expect(result.IsValid).To(BeTrue())
+			Expect(result.Errors).To(BeEmpty())
+			Expect(result.SchemaVersion).To(Equal("v0.6.0"))
+		})
+
+		It("should stream validation results as NDJSON", func() {
+			var body bytes.Buffer
+			for i := range 3 {
+				body.Write(recordRequest(validV060Record, map[string]any{"correlation_id": fmt.Sprint(i)}))
+				body.WriteByte('\n')
+			}
+
+			resp := post(baseURL+"/v1/records:validateStream", "application/x-ndjson", body.Bytes())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			var correlationIDs []string
+
+			scanner := bufio.NewScanner(resp.Body)
+			scanner.Buffer(nil, 1<<20)
+			for scanner.Scan() {
+				var line struct {
+					Result struct {
+						IsValid       bool   `json:"is_valid"`
+						CorrelationID string `json:"correlation_id"`
+					} `json:"result"`
+				}
+				Expect(json.Unmarshal(scanner.Bytes(), &line)).To(Succeed())
+				Expect(line.Result.IsValid).To(BeTrue())
+
+				correlationIDs = append(correlationIDs, line.Result.CorrelationID)
+			}
+			Expect(scanner.Err()).NotTo(HaveOccurred())
+			Expect(correlationIDs).To(Equal([]string{"0", "1", "2"}))
+		})
+
+		It("should serve the OpenAPI document", func() {
+			resp, err := httpClient.Get(baseURL + "/openapi/validation_service.swagger.json")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			var document struct {
+				Paths map[string]any `json:"paths"`
+			}
+			Expect(json.NewDecoder(resp.Body).Decode(&document)).To(Succeed())
+			Expect(document.Paths).To(HaveKey("/v1/records:validate"))
+		})
+	})
+
+	Context("translation", func() {
+		baseURL := "http://" + translationServer.http
+
+		It("should translate a record to an A2A card", func() {
+			resp := post(baseURL+"/v1/records:toA2A", "application/json", recordRequest(translationRecord, nil))
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			var result struct {
+				Data struct {
+					A2ACard map[string]any `json:"a2aCard"`
+				} `json:"data"`
+			}
+			Expect(json.Unmarshal(body, &result)).To(Succeed())
+			Expect(result.Data.A2ACard).To(HaveKeyWithValue("name", "example-agent"))
+		})
+	})
+})
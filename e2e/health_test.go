@@ -0,0 +1,47 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Health E2E", func() {
+	check := func(address, service string) (*healthpb.HealthCheckResponse, error) {
+		conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		return healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	}
+
+	DescribeTable("should report the servers and their services as serving",
+		func(address, service string) {
+			resp, err := check(address, service)
+			Expect(err).NotTo(HaveOccurred(), "Health check should not fail")
+			Expect(resp.GetStatus()).To(Equal(healthpb.HealthCheckResponse_SERVING))
+		},
+		Entry("validation server", validationServer.grpc, ""),
+		Entry("validation service", validationServer.grpc, "validation.v1.ValidationService"),
+		Entry("translation server", translationServer.grpc, ""),
+		Entry("translation service", translationServer.grpc, "translation.v1.TranslationService"),
+	)
+
+	It("should not know services that are not served", func() {
+		_, err := check(translationServer.grpc, "validation.v1.ValidationService")
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+})
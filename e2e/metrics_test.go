@@ -0,0 +1,78 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	translationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/translation/v1/translationv1grpc"
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	translationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var _ = Describe("Metrics E2E", func() {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	scrape := func(url string) string {
+		resp, err := httpClient.Get(url)
+		Expect(err).NotTo(HaveOccurred(), "Metrics request should not fail")
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+
+		return string(body)
+	}
+
+	It("should report RPCs and validation outcomes", func() {
+		conn, err := grpc.NewClient(validationServer.grpc, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		var record objectsv3.Record
+		Expect(json.Unmarshal(validV060Record, &record)).To(Succeed())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_, err = validationv1grpc.NewValidationServiceClient(conn).ValidateRecord(ctx, &validationv1.ValidateRecordRequest{Record: &record})
+		Expect(err).NotTo(HaveOccurred(), "ValidateRecord should not fail")
+
+		metrics := scrape("http://" + validationServer.metrics + "/metrics")
+		Expect(metrics).To(ContainSubstring(`grpc_server_handled_total{grpc_code="OK",grpc_method="ValidateRecord",grpc_service="validation.v1.ValidationService",grpc_type="unary"}`))
+		Expect(metrics).To(ContainSubstring(`grpc_server_handling_seconds_bucket{grpc_method="ValidateRecord"`))
+		Expect(metrics).To(ContainSubstring(`oasf_validation_records_total{outcome="valid",schema_version="v0.6.0"}`))
+	})
+
+	It("should report translations", func() {
+		conn, err := grpc.NewClient(translationServer.grpc, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		var record objectsv3.Record
+		Expect(json.Unmarshal(translationRecord, &record)).To(Succeed())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_, err = translationv1grpc.NewTranslationServiceClient(conn).RecordToA2A(ctx, &translationv1.RecordToA2ARequest{Record: &record})
+		Expect(err).NotTo(HaveOccurred(), "RecordToA2A should not fail")
+
+		metrics := scrape("http://" + translationServer.metrics + "/metrics")
+		Expect(metrics).To(ContainSubstring(`grpc_server_handled_total{grpc_code="OK",grpc_method="RecordToA2A",grpc_service="translation.v1.TranslationService",grpc_type="unary"}`))
+		Expect(metrics).To(ContainSubstring(`oasf_translation_translations_total{format="a2a",reason=""}`))
+	})
+})
@@ -0,0 +1,157 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
+	translationconfig "github.com/agntcy/oasf-sdk/translation/config"
+	translationserver "github.com/agntcy/oasf-sdk/translation/server"
+	validationconfig "github.com/agntcy/oasf-sdk/validation/config"
+	validationserver "github.com/agntcy/oasf-sdk/validation/server"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// containersEnv selects the servers under test. When true, the tests target the servers started in
+// containers by the e2e workflow, on their default ports. Otherwise, the servers are started in-process
+// on ephemeral ports.
+const containersEnv = "OASF_E2E_CONTAINERS"
+
+// remoteRecordSchemaURL is the URL of the record schema v0.6.0 on the schema server, which the servers
+// in containers validate against. Specs that use it are labeled with networkLabel.
+const remoteRecordSchemaURL = "https://schema.oasf.outshift.com/schema/0.6.0/objects/record"
+
+// networkLabel labels the specs that need the network.
+const networkLabel = "network"
+
+// serverAddresses are the addresses of the gRPC, HTTP/JSON and metrics endpoints of a server.
+type serverAddresses struct {
+	grpc    string
+	http    string
+	metrics string
+
+	// listeners are open on the addresses of an in-process server until it serves them.
+	listeners commonserver.Listeners
+}
+
+// apply sets the listen addresses of a server configuration.
+func (a serverAddresses) apply(cfg *commonserver.Config) {
+	cfg.ListenAddress = a.grpc
+	cfg.HTTPListenAddress = a.http
+	cfg.MetricsListenAddress = a.metrics
+}
+
+var useContainers = func() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(containersEnv))
+
+	return enabled
+}()
+
+// The addresses are known before the specs are built, as the specs connect to the servers when they
+// are built, while the in-process servers are started before the suite runs. The ports of the in-process
+// servers are held by their listeners in the meantime.
+var (
+	validationServer  = newServerAddresses("31235", "31245", "31255")
+	translationServer = newServerAddresses("31234", "31244", "31254")
+)
+
+// recordSchemaURL is the URL of a record schema v0.6.0. The in-process servers validate against
+// the fixture served by schemaServer, so that the specs do not need the network.
+var recordSchemaURL, schemaServer = func() (string, *httptest.Server) {
+	if useContainers {
+		return remoteRecordSchemaURL, nil
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(recordSchema)
+	}))
+
+	return server.URL + "/schema/0.6.0/objects/record", server
+}()
+
+// recordSchemaLabels are the labels of the specs that validate against recordSchemaURL.
+var recordSchemaLabels = func() Labels {
+	if useContainers {
+		return Label(networkLabel)
+	}
+
+	return Label()
+}()
+
+// newServerAddresses returns the default ports of a server in containers, or else listeners on ephemeral ports.
+func newServerAddresses(grpcPort, httpPort, metricsPort string) serverAddresses {
+	if useContainers {
+		return serverAddresses{
+			grpc:    net.JoinHostPort("0.0.0.0", grpcPort),
+			http:    net.JoinHostPort("0.0.0.0", httpPort),
+			metrics: net.JoinHostPort("0.0.0.0", metricsPort),
+		}
+	}
+
+	listeners := commonserver.Listeners{
+		GRPC:    listenLocal(),
+		HTTP:    listenLocal(),
+		Metrics: listenLocal(),
+	}
+
+	return serverAddresses{
+		grpc:      listeners.GRPC.Addr().String(),
+		http:      listeners.HTTP.Addr().String(),
+		metrics:   listeners.Metrics.Addr().String(),
+		listeners: listeners,
+	}
+}
+
+// listenLocal listens on an ephemeral port of the loopback interface.
+func listenLocal() net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	return listener
+}
+
+// startServer serves service in-process with cfg on the listeners of addresses.
+func startServer(cfg commonserver.Config, addresses serverAddresses, service commonserver.Service) {
+	addresses.apply(&cfg)
+
+	server, err := commonserver.NewServer(context.Background(), cfg, service)
+	Expect(err).NotTo(HaveOccurred(), "Failed to create server %s", service.Name)
+
+	// Whatever was started is stopped when the server fails to start.
+	DeferCleanup(server.Close)
+
+	Expect(server.Start(addresses.listeners)).To(Succeed(), "Failed to start server %s", service.Name)
+	Expect(commonserver.Probe(context.Background(), cfg, "")).To(Succeed(), "Server %s should serve", service.Name)
+}
+
+var _ = BeforeSuite(func() {
+	if useContainers {
+		return
+	}
+
+	DeferCleanup(schemaServer.Close)
+
+	validationCfg, err := validationconfig.LoadConfig(nil)
+	Expect(err).NotTo(HaveOccurred(), "Failed to load validation server configuration")
+
+	validationService, err := validationserver.NewService(validationCfg)
+	Expect(err).NotTo(HaveOccurred(), "Failed to create validation service")
+
+	startServer(validationCfg.Config, validationServer, validationService)
+
+	translationCfg, err := translationconfig.LoadConfig(nil)
+	Expect(err).NotTo(HaveOccurred(), "Failed to load translation server configuration")
+
+	startServer(translationCfg.Config, translationServer, translationserver.NewService())
+})
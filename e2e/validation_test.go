@@ -5,7 +5,10 @@ package e2e
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
@@ -14,12 +17,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 var _ = Describe("Validation Service E2E", func() {
-	conn, err := grpc.NewClient(fmt.Sprintf("%s:%s", "0.0.0.0", "31235"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(validationServer.grpc, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	Expect(err).NotTo(HaveOccurred())
 
 	client := validationv1grpc.NewValidationServiceClient(conn)
@@ -28,6 +33,7 @@ var _ = Describe("Validation Service E2E", func() {
 		name       string
 		jsonData   []byte
 		schemaURL  string
+		labels     Labels
 		shouldPass bool
 	}{
 		{
@@ -43,7 +49,8 @@ var _ = Describe("Validation Service E2E", func() {
 		{
 			name:       "valid_record_v0.6.0.json with explicit schema URL",
 			jsonData:   validV060Record,
-			schemaURL:  "https://schema.oasf.outshift.com/schema/0.6.0/objects/record",
+			schemaURL:  recordSchemaURL,
+			labels:     recordSchemaLabels,
 			shouldPass: true,
 		},
 		{
@@ -53,7 +60,7 @@ var _ = Describe("Validation Service E2E", func() {
 	}
 
 	for _, tc := range testCases {
-		Context(tc.name, func() {
+		Context(tc.name, tc.labels, func() {
 			It("should return valid with no errors", func() {
 				var record objectsv3.Record
 				err := protojson.Unmarshal(tc.jsonData, &record)
@@ -80,4 +87,207 @@ var _ = Describe("Validation Service E2E", func() {
 			})
 		})
 	}
+
+	Context("record document validation", func() {
+		It("should report issues with their position in a YAML document", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			resp, err := client.ValidateRecordDocument(ctx, &validationv1.ValidateRecordDocumentRequest{
+				Document: invalidV060RecordYAML,
+			})
+			Expect(err).NotTo(HaveOccurred(), "ValidateRecordDocument should not fail")
+			Expect(resp.IsValid).To(BeFalse(), "Expected invalid record document")
+
+			var paths []string
+			for _, issue := range resp.Issues {
+				paths = append(paths, issue.Path)
+
+				switch issue.Path {
+				case "/unknown_field":
+					Expect(issue.Keyword).To(Equal("additionalProperties"))
+					Expect(issue.Line).To(BeEquivalentTo(8))
+					Expect(issue.Column).To(BeEquivalentTo(1))
+				case "/locators/0/size":
+					Expect(issue.Keyword).To(Equal("type"))
+					Expect(issue.Line).To(BeEquivalentTo(18))
+					Expect(issue.Column).To(BeEquivalentTo(11))
+				}
+			}
+			Expect(paths).To(ContainElements("/unknown_field", "/locators/0/size"))
+		})
+
+		It("should report syntax errors with their position in a JSON document", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			resp, err := client.ValidateRecordDocument(ctx, &validationv1.ValidateRecordDocumentRequest{
+				Document: []byte("{\n  \"schema_version\": \"v0.6.0\",\n  \"name\" 1\n}"),
+				Format:   validationv1.DocumentFormat_DOCUMENT_FORMAT_JSON,
+			})
+			Expect(err).NotTo(HaveOccurred(), "ValidateRecordDocument should not fail")
+			Expect(resp.IsValid).To(BeFalse(), "Expected invalid record document")
+			Expect(resp.Issues).To(HaveLen(1))
+			Expect(resp.Issues[0].Keyword).To(Equal("syntax"))
+			Expect(resp.Issues[0].Line).To(BeEquivalentTo(3))
+		})
+
+		It("should include a SARIF log of the issues when requested", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			resp, err := client.ValidateRecordDocument(ctx, &validationv1.ValidateRecordDocumentRequest{
+				Document:     invalidV060RecordYAML,
+				IncludeSarif: true,
+				DocumentUri:  "records/invalid_v0.6.0_record.yaml",
+			})
+			Expect(err).NotTo(HaveOccurred(), "ValidateRecordDocument should not fail")
+			Expect(resp.Sarif).NotTo(BeEmpty(), "Expected a SARIF log")
+
+			var log struct {
+				Version string `json:"version"`
+				Runs    []struct {
+					Results []struct {
+						RuleID    string `json:"ruleId"`
+						Locations []struct {
+							PhysicalLocation struct {
+								ArtifactLocation struct {
+									URI string `json:"uri"`
+								} `json:"artifactLocation"`
+								Region struct {
+									StartLine   int `json:"startLine"`
+									StartColumn int `json:"startColumn"`
+								} `json:"region"`
+							} `json:"physicalLocation"`
+						} `json:"locations"`
+					} `json:"results"`
+				} `json:"runs"`
+			}
+			Expect(json.Unmarshal([]byte(resp.Sarif), &log)).To(Succeed(), "Failed to decode SARIF log")
+			Expect(log.Version).To(Equal("2.1.0"))
+			Expect(log.Runs).To(HaveLen(1))
+			Expect(log.Runs[0].Results).To(HaveLen(len(resp.Issues)))
+
+			for i, result := range log.Runs[0].Results {
+				Expect(result.RuleID).To(Equal(resp.Issues[i].Keyword))
+				Expect(result.Locations).To(HaveLen(1))
+
+				location := result.Locations[0].PhysicalLocation
+				Expect(location.ArtifactLocation.URI).To(Equal("records/invalid_v0.6.0_record.yaml"))
+				Expect(location.Region.StartLine).To(BeEquivalentTo(resp.Issues[i].Line))
+				Expect(location.Region.StartColumn).To(BeEquivalentTo(resp.Issues[i].Column))
+			}
+		})
+	})
+
+	Context("schema version detection", func() {
+		It("should validate a record with an unknown schema version against the detected version", func() {
+			var record objectsv3.Record
+			err := protojson.Unmarshal(validV050Record, &record)
+			Expect(err).NotTo(HaveOccurred(), "Failed to unmarshal record")
+			record.SchemaVersion = "v0.5.7"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			_, err = client.ValidateRecord(ctx, &validationv1.ValidateRecordRequest{Record: &record})
+			Expect(err).To(HaveOccurred(), "ValidateRecord should fail without detection")
+
+			resp, err := client.ValidateRecord(ctx, &validationv1.ValidateRecordRequest{
+				Record:              &record,
+				DetectSchemaVersion: true,
+			})
+			Expect(err).NotTo(HaveOccurred(), "ValidateRecord should not fail with detection")
+			Expect(resp.IsValid).To(BeTrue(), "Expected valid record", resp.Errors)
+			Expect(resp.SchemaVersion).To(Equal("v0.5.0"))
+			Expect(resp.Warnings).NotTo(BeEmpty())
+		})
+	})
+
+	Context("stream validation", func() {
+		It("should report per-item errors without ending the stream", func() {
+			var record objectsv3.Record
+			err := protojson.Unmarshal(validV060Record, &record)
+			Expect(err).NotTo(HaveOccurred(), "Failed to unmarshal record")
+
+			unknownVersionRecord := proto.Clone(&record).(*objectsv3.Record)
+			unknownVersionRecord.SchemaVersion = "v9.9.9"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			stream, err := client.ValidateRecordStream(ctx)
+			Expect(err).NotTo(HaveOccurred(), "ValidateRecordStream should not fail")
+
+			requests := []*validationv1.ValidateRecordStreamRequest{
+				{Record: &record, CorrelationId: "first"},
+				{Record: unknownVersionRecord, CorrelationId: "second"},
+				{Record: &record, CorrelationId: "third"},
+			}
+			for _, req := range requests {
+				Expect(stream.Send(req)).To(Succeed())
+			}
+			Expect(stream.CloseSend()).To(Succeed())
+
+			var responses []*validationv1.ValidateRecordStreamResponse
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).NotTo(HaveOccurred(), "stream should not fail")
+				responses = append(responses, resp)
+			}
+
+			Expect(responses).To(HaveLen(3))
+			Expect(responses[0].CorrelationId).To(Equal("first"))
+			Expect(responses[0].IsValid).To(BeTrue())
+			Expect(responses[1].CorrelationId).To(Equal("second"))
+			Expect(responses[1].IsValid).To(BeFalse())
+			Expect(responses[1].Error).NotTo(BeNil())
+			Expect(codes.Code(responses[1].Error.Code)).To(Equal(codes.NotFound))
+			Expect(responses[2].CorrelationId).To(Equal("third"))
+			Expect(responses[2].IsValid).To(BeTrue())
+		})
+
+		It("should return every item of an unordered stream", func() {
+			var record objectsv3.Record
+			err := protojson.Unmarshal(validV060Record, &record)
+			Expect(err).NotTo(HaveOccurred(), "Failed to unmarshal record")
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			stream, err := client.ValidateRecordStream(ctx)
+			Expect(err).NotTo(HaveOccurred(), "ValidateRecordStream should not fail")
+
+			const items = 100
+			go func() {
+				defer GinkgoRecover()
+
+				for i := range items {
+					Expect(stream.Send(&validationv1.ValidateRecordStreamRequest{
+						Record:        &record,
+						CorrelationId: fmt.Sprintf("record-%d", i),
+						Unordered:     true,
+					})).To(Succeed())
+				}
+				Expect(stream.CloseSend()).To(Succeed())
+			}()
+
+			seen := map[string]bool{}
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).NotTo(HaveOccurred(), "stream should not fail")
+				Expect(resp.Error).To(BeNil())
+				Expect(resp.IsValid).To(BeTrue())
+				seen[resp.CorrelationId] = true
+			}
+
+			Expect(seen).To(HaveLen(items))
+		})
+	})
 })
@@ -0,0 +1,8 @@
+module buf.build/gen/go/agntcy/oasf-sdk/grpc/go
+
+go 1.19
+
+require (
+	buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go v1.36.8-20250822074012-8eed55f5aabc.1
+	google.golang.org/grpc v1.64.1
+)
@@ -0,0 +1,249 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: translation/v1/translation_service.proto
+
+package translationv1grpc
+
+import (
+	v1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TranslationService_RecordToVSCodeCopilot_FullMethodName = "/translation.v1.TranslationService/RecordToVSCodeCopilot"
+	TranslationService_GHCopilotToRecord_FullMethodName     = "/translation.v1.TranslationService/GHCopilotToRecord"
+	TranslationService_RecordToA2A_FullMethodName           = "/translation.v1.TranslationService/RecordToA2A"
+	TranslationService_A2AToRecord_FullMethodName           = "/translation.v1.TranslationService/A2AToRecord"
+)
+
+// TranslationServiceClient is the client API for TranslationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TranslationService provides methods to generate artifacts from OASF objects.
+type TranslationServiceClient interface {
+	// RecordToVSCodeCopilot generates a VSCodeCopilot config from a Record.
+	RecordToVSCodeCopilot(ctx context.Context, in *v1.RecordToVSCodeCopilotRequest, opts ...grpc.CallOption) (*v1.RecordToVSCodeCopilotResponse, error)
+	// GHCopilotToRecord generates a Record from a GHCopilot config.
+	GHCopilotToRecord(ctx context.Context, in *v1.GHCopilotToRecordRequest, opts ...grpc.CallOption) (*v1.GHCopilotToRecordResponse, error)
+	// RecordToA2A generates an A2A card from a Record.
+	RecordToA2A(ctx context.Context, in *v1.RecordToA2ARequest, opts ...grpc.CallOption) (*v1.RecordToA2AResponse, error)
+	// A2AToRecord generates a Record from an A2A card.
+	A2AToRecord(ctx context.Context, in *v1.A2AToRecordRequest, opts ...grpc.CallOption) (*v1.A2AToRecordResponse, error)
+}
+
+type translationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTranslationServiceClient(cc grpc.ClientConnInterface) TranslationServiceClient {
+	return &translationServiceClient{cc}
+}
+
+func (c *translationServiceClient) RecordToVSCodeCopilot(ctx context.Context, in *v1.RecordToVSCodeCopilotRequest, opts ...grpc.CallOption) (*v1.RecordToVSCodeCopilotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RecordToVSCodeCopilotResponse)
+	err := c.cc.Invoke(ctx, TranslationService_RecordToVSCodeCopilot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translationServiceClient) GHCopilotToRecord(ctx context.Context, in *v1.GHCopilotToRecordRequest, opts ...grpc.CallOption) (*v1.GHCopilotToRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GHCopilotToRecordResponse)
+	err := c.cc.Invoke(ctx, TranslationService_GHCopilotToRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translationServiceClient) RecordToA2A(ctx context.Context, in *v1.RecordToA2ARequest, opts ...grpc.CallOption) (*v1.RecordToA2AResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RecordToA2AResponse)
+	err := c.cc.Invoke(ctx, TranslationService_RecordToA2A_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translationServiceClient) A2AToRecord(ctx context.Context, in *v1.A2AToRecordRequest, opts ...grpc.CallOption) (*v1.A2AToRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.A2AToRecordResponse)
+	err := c.cc.Invoke(ctx, TranslationService_A2AToRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TranslationServiceServer is the server API for TranslationService service.
+// All implementations should embed UnimplementedTranslationServiceServer
+// for forward compatibility.
+//
+// TranslationService provides methods to generate artifacts from OASF objects.
+type TranslationServiceServer interface {
+	// RecordToVSCodeCopilot generates a VSCodeCopilot config from a Record.
+	RecordToVSCodeCopilot(context.Context, *v1.RecordToVSCodeCopilotRequest) (*v1.RecordToVSCodeCopilotResponse, error)
+	// GHCopilotToRecord generates a Record from a GHCopilot config.
+	GHCopilotToRecord(context.Context, *v1.GHCopilotToRecordRequest) (*v1.GHCopilotToRecordResponse, error)
+	// RecordToA2A generates an A2A card from a Record.
+	RecordToA2A(context.Context, *v1.RecordToA2ARequest) (*v1.RecordToA2AResponse, error)
+	// A2AToRecord generates a Record from an A2A card.
+	A2AToRecord(context.Context, *v1.A2AToRecordRequest) (*v1.A2AToRecordResponse, error)
+}
+
+// UnimplementedTranslationServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTranslationServiceServer struct{}
+
+func (UnimplementedTranslationServiceServer) RecordToVSCodeCopilot(context.Context, *v1.RecordToVSCodeCopilotRequest) (*v1.RecordToVSCodeCopilotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordToVSCodeCopilot not implemented")
+}
+func (UnimplementedTranslationServiceServer) GHCopilotToRecord(context.Context, *v1.GHCopilotToRecordRequest) (*v1.GHCopilotToRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GHCopilotToRecord not implemented")
+}
+func (UnimplementedTranslationServiceServer) RecordToA2A(context.Context, *v1.RecordToA2ARequest) (*v1.RecordToA2AResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordToA2A not implemented")
+}
+func (UnimplementedTranslationServiceServer) A2AToRecord(context.Context, *v1.A2AToRecordRequest) (*v1.A2AToRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method A2AToRecord not implemented")
+}
+func (UnimplementedTranslationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTranslationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TranslationServiceServer will
+// result in compilation errors.
+type UnsafeTranslationServiceServer interface {
+	mustEmbedUnimplementedTranslationServiceServer()
+}
+
+func RegisterTranslationServiceServer(s grpc.ServiceRegistrar, srv TranslationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTranslationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TranslationService_ServiceDesc, srv)
+}
+
+func _TranslationService_RecordToVSCodeCopilot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RecordToVSCodeCopilotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).RecordToVSCodeCopilot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TranslationService_RecordToVSCodeCopilot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).RecordToVSCodeCopilot(ctx, req.(*v1.RecordToVSCodeCopilotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslationService_GHCopilotToRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GHCopilotToRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).GHCopilotToRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TranslationService_GHCopilotToRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).GHCopilotToRecord(ctx, req.(*v1.GHCopilotToRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslationService_RecordToA2A_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RecordToA2ARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).RecordToA2A(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TranslationService_RecordToA2A_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).RecordToA2A(ctx, req.(*v1.RecordToA2ARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslationService_A2AToRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.A2AToRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationServiceServer).A2AToRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TranslationService_A2AToRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationServiceServer).A2AToRecord(ctx, req.(*v1.A2AToRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TranslationService_ServiceDesc is the grpc.ServiceDesc for TranslationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TranslationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "translation.v1.TranslationService",
+	HandlerType: (*TranslationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordToVSCodeCopilot",
+			Handler:    _TranslationService_RecordToVSCodeCopilot_Handler,
+		},
+		{
+			MethodName: "GHCopilotToRecord",
+			Handler:    _TranslationService_GHCopilotToRecord_Handler,
+		},
+		{
+			MethodName: "RecordToA2A",
+			Handler:    _TranslationService_RecordToA2A_Handler,
+		},
+		{
+			MethodName: "A2AToRecord",
+			Handler:    _TranslationService_A2AToRecord_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "translation/v1/translation_service.proto",
+}
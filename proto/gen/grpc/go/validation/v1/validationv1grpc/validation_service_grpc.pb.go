@@ -0,0 +1,212 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: validation/v1/validation_service.proto
+
+package validationv1grpc
+
+import (
+	v1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ValidationService_ValidateRecord_FullMethodName         = "/validation.v1.ValidationService/ValidateRecord"
+	ValidationService_ValidateRecordStream_FullMethodName   = "/validation.v1.ValidationService/ValidateRecordStream"
+	ValidationService_ValidateRecordDocument_FullMethodName = "/validation.v1.ValidationService/ValidateRecordDocument"
+)
+
+// ValidationServiceClient is the client API for ValidationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ValidationService provides methods to validate OASF objects.
+type ValidationServiceClient interface {
+	// ValidateRecord checks the validity of a Record object.
+	ValidateRecord(ctx context.Context, in *v1.ValidateRecordRequest, opts ...grpc.CallOption) (*v1.ValidateRecordResponse, error)
+	// ValidateRecordStream checks the validity of multiple Record objects using stream.
+	// Items are validated concurrently, but responses are sent in input order, ie. the response on the stream is tied
+	// to the given object passed from the stream, unless the client opts into unordered responses.
+	// Items that cannot be validated are reported with an error in their response, and the stream continues.
+	ValidateRecordStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse], error)
+	// ValidateRecordDocument checks the validity of a raw Record document encoded as JSON or YAML.
+	// The document is validated as-is against the schema, and each issue reports its position in the original document.
+	ValidateRecordDocument(ctx context.Context, in *v1.ValidateRecordDocumentRequest, opts ...grpc.CallOption) (*v1.ValidateRecordDocumentResponse, error)
+}
+
+type validationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidationServiceClient(cc grpc.ClientConnInterface) ValidationServiceClient {
+	return &validationServiceClient{cc}
+}
+
+func (c *validationServiceClient) ValidateRecord(ctx context.Context, in *v1.ValidateRecordRequest, opts ...grpc.CallOption) (*v1.ValidateRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ValidateRecordResponse)
+	err := c.cc.Invoke(ctx, ValidationService_ValidateRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validationServiceClient) ValidateRecordStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ValidationService_ServiceDesc.Streams[0], ValidationService_ValidateRecordStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ValidationService_ValidateRecordStreamClient = grpc.BidiStreamingClient[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse]
+
+func (c *validationServiceClient) ValidateRecordDocument(ctx context.Context, in *v1.ValidateRecordDocumentRequest, opts ...grpc.CallOption) (*v1.ValidateRecordDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ValidateRecordDocumentResponse)
+	err := c.cc.Invoke(ctx, ValidationService_ValidateRecordDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidationServiceServer is the server API for ValidationService service.
+// All implementations should embed UnimplementedValidationServiceServer
+// for forward compatibility.
+//
+// ValidationService provides methods to validate OASF objects.
+type ValidationServiceServer interface {
+	// ValidateRecord checks the validity of a Record object.
+	ValidateRecord(context.Context, *v1.ValidateRecordRequest) (*v1.ValidateRecordResponse, error)
+	// ValidateRecordStream checks the validity of multiple Record objects using stream.
+	// Items are validated concurrently, but responses are sent in input order, ie. the response on the stream is tied
+	// to the given object passed from the stream, unless the client opts into unordered responses.
+	// Items that cannot be validated are reported with an error in their response, and the stream continues.
+	ValidateRecordStream(grpc.BidiStreamingServer[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse]) error
+	// ValidateRecordDocument checks the validity of a raw Record document encoded as JSON or YAML.
+	// The document is validated as-is against the schema, and each issue reports its position in the original document.
+	ValidateRecordDocument(context.Context, *v1.ValidateRecordDocumentRequest) (*v1.ValidateRecordDocumentResponse, error)
+}
+
+// UnimplementedValidationServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedValidationServiceServer struct{}
+
+func (UnimplementedValidationServiceServer) ValidateRecord(context.Context, *v1.ValidateRecordRequest) (*v1.ValidateRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateRecord not implemented")
+}
+func (UnimplementedValidationServiceServer) ValidateRecordStream(grpc.BidiStreamingServer[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateRecordStream not implemented")
+}
+func (UnimplementedValidationServiceServer) ValidateRecordDocument(context.Context, *v1.ValidateRecordDocumentRequest) (*v1.ValidateRecordDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateRecordDocument not implemented")
+}
+func (UnimplementedValidationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeValidationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ValidationServiceServer will
+// result in compilation errors.
+type UnsafeValidationServiceServer interface {
+	mustEmbedUnimplementedValidationServiceServer()
+}
+
+func RegisterValidationServiceServer(s grpc.ServiceRegistrar, srv ValidationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedValidationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ValidationService_ServiceDesc, srv)
+}
+
+func _ValidationService_ValidateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ValidateRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidationServiceServer).ValidateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidationService_ValidateRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidationServiceServer).ValidateRecord(ctx, req.(*v1.ValidateRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidationService_ValidateRecordStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ValidationServiceServer).ValidateRecordStream(&grpc.GenericServerStream[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ValidationService_ValidateRecordStreamServer = grpc.BidiStreamingServer[v1.ValidateRecordStreamRequest, v1.ValidateRecordStreamResponse]
+
+func _ValidationService_ValidateRecordDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ValidateRecordDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidationServiceServer).ValidateRecordDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidationService_ValidateRecordDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidationServiceServer).ValidateRecordDocument(ctx, req.(*v1.ValidateRecordDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ValidationService_ServiceDesc is the grpc.ServiceDesc for ValidationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ValidationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "validation.v1.ValidationService",
+	HandlerType: (*ValidationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateRecord",
+			Handler:    _ValidationService_ValidateRecord_Handler,
+		},
+		{
+			MethodName: "ValidateRecordDocument",
+			Handler:    _ValidationService_ValidateRecordDocument_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidateRecordStream",
+			Handler:       _ValidationService_ValidateRecordStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "validation/v1/validation_service.proto",
+}
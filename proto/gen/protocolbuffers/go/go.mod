@@ -0,0 +1,8 @@
+module buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go
+
+go 1.23
+
+require (
+	buf.build/gen/go/agntcy/oasf/protocolbuffers/go v1.36.8-20250730151615-132f40d05b24.1
+	google.golang.org/protobuf v1.36.8
+)
@@ -0,0 +1,668 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: translation/v1/translation_service.proto
+
+//go:build protoopaque
+
+package translationv1
+
+import (
+	v3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecordToVSCodeCopilotRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Record *v3.Record             `protobuf:"bytes,2,opt,name=record,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecordToVSCodeCopilotRequest) Reset() {
+	*x = RecordToVSCodeCopilotRequest{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordToVSCodeCopilotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordToVSCodeCopilotRequest) ProtoMessage() {}
+
+func (x *RecordToVSCodeCopilotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RecordToVSCodeCopilotRequest) GetRecord() *v3.Record {
+	if x != nil {
+		return x.xxx_hidden_Record
+	}
+	return nil
+}
+
+func (x *RecordToVSCodeCopilotRequest) SetRecord(v *v3.Record) {
+	x.xxx_hidden_Record = v
+}
+
+func (x *RecordToVSCodeCopilotRequest) HasRecord() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Record != nil
+}
+
+func (x *RecordToVSCodeCopilotRequest) ClearRecord() {
+	x.xxx_hidden_Record = nil
+}
+
+type RecordToVSCodeCopilotRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The Record object to be converted into a VSCodeCopilot config.
+	Record *v3.Record
+}
+
+func (b0 RecordToVSCodeCopilotRequest_builder) Build() *RecordToVSCodeCopilotRequest {
+	m0 := &RecordToVSCodeCopilotRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Record = b.Record
+	return m0
+}
+
+type RecordToVSCodeCopilotResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecordToVSCodeCopilotResponse) Reset() {
+	*x = RecordToVSCodeCopilotResponse{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordToVSCodeCopilotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordToVSCodeCopilotResponse) ProtoMessage() {}
+
+func (x *RecordToVSCodeCopilotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RecordToVSCodeCopilotResponse) GetData() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *RecordToVSCodeCopilotResponse) SetData(v *structpb.Struct) {
+	x.xxx_hidden_Data = v
+}
+
+func (x *RecordToVSCodeCopilotResponse) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Data != nil
+}
+
+func (x *RecordToVSCodeCopilotResponse) ClearData() {
+	x.xxx_hidden_Data = nil
+}
+
+type RecordToVSCodeCopilotResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The generated VSCodeCopilot config in a structured format.
+	Data *structpb.Struct
+}
+
+func (b0 RecordToVSCodeCopilotResponse_builder) Build() *RecordToVSCodeCopilotResponse {
+	m0 := &RecordToVSCodeCopilotResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Data = b.Data
+	return m0
+}
+
+type GHCopilotToRecordRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GHCopilotToRecordRequest) Reset() {
+	*x = GHCopilotToRecordRequest{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GHCopilotToRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GHCopilotToRecordRequest) ProtoMessage() {}
+
+func (x *GHCopilotToRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GHCopilotToRecordRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *GHCopilotToRecordRequest) SetData(v *structpb.Struct) {
+	x.xxx_hidden_Data = v
+}
+
+func (x *GHCopilotToRecordRequest) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Data != nil
+}
+
+func (x *GHCopilotToRecordRequest) ClearData() {
+	x.xxx_hidden_Data = nil
+}
+
+type GHCopilotToRecordRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The GHCopilot config to be converted to Record object.
+	Data *structpb.Struct
+}
+
+func (b0 GHCopilotToRecordRequest_builder) Build() *GHCopilotToRecordRequest {
+	m0 := &GHCopilotToRecordRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Data = b.Data
+	return m0
+}
+
+type GHCopilotToRecordResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Record *v3.Record             `protobuf:"bytes,1,opt,name=record,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GHCopilotToRecordResponse) Reset() {
+	*x = GHCopilotToRecordResponse{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GHCopilotToRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GHCopilotToRecordResponse) ProtoMessage() {}
+
+func (x *GHCopilotToRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GHCopilotToRecordResponse) GetRecord() *v3.Record {
+	if x != nil {
+		return x.xxx_hidden_Record
+	}
+	return nil
+}
+
+func (x *GHCopilotToRecordResponse) SetRecord(v *v3.Record) {
+	x.xxx_hidden_Record = v
+}
+
+func (x *GHCopilotToRecordResponse) HasRecord() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Record != nil
+}
+
+func (x *GHCopilotToRecordResponse) ClearRecord() {
+	x.xxx_hidden_Record = nil
+}
+
+type GHCopilotToRecordResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The generated Record object in a structured format.
+	Record *v3.Record
+}
+
+func (b0 GHCopilotToRecordResponse_builder) Build() *GHCopilotToRecordResponse {
+	m0 := &GHCopilotToRecordResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Record = b.Record
+	return m0
+}
+
+type RecordToA2ARequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Record *v3.Record             `protobuf:"bytes,1,opt,name=record,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecordToA2ARequest) Reset() {
+	*x = RecordToA2ARequest{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordToA2ARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordToA2ARequest) ProtoMessage() {}
+
+func (x *RecordToA2ARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RecordToA2ARequest) GetRecord() *v3.Record {
+	if x != nil {
+		return x.xxx_hidden_Record
+	}
+	return nil
+}
+
+func (x *RecordToA2ARequest) SetRecord(v *v3.Record) {
+	x.xxx_hidden_Record = v
+}
+
+func (x *RecordToA2ARequest) HasRecord() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Record != nil
+}
+
+func (x *RecordToA2ARequest) ClearRecord() {
+	x.xxx_hidden_Record = nil
+}
+
+type RecordToA2ARequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The Record object to be converted into an A2A card.
+	Record *v3.Record
+}
+
+func (b0 RecordToA2ARequest_builder) Build() *RecordToA2ARequest {
+	m0 := &RecordToA2ARequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Record = b.Record
+	return m0
+}
+
+type RecordToA2AResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecordToA2AResponse) Reset() {
+	*x = RecordToA2AResponse{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordToA2AResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordToA2AResponse) ProtoMessage() {}
+
+func (x *RecordToA2AResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RecordToA2AResponse) GetData() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *RecordToA2AResponse) SetData(v *structpb.Struct) {
+	x.xxx_hidden_Data = v
+}
+
+func (x *RecordToA2AResponse) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Data != nil
+}
+
+func (x *RecordToA2AResponse) ClearData() {
+	x.xxx_hidden_Data = nil
+}
+
+type RecordToA2AResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The generated A2A card data in a structured format.
+	Data *structpb.Struct
+}
+
+func (b0 RecordToA2AResponse_builder) Build() *RecordToA2AResponse {
+	m0 := &RecordToA2AResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Data = b.Data
+	return m0
+}
+
+type A2AToRecordRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *A2AToRecordRequest) Reset() {
+	*x = A2AToRecordRequest{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *A2AToRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*A2AToRecordRequest) ProtoMessage() {}
+
+func (x *A2AToRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *A2AToRecordRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *A2AToRecordRequest) SetData(v *structpb.Struct) {
+	x.xxx_hidden_Data = v
+}
+
+func (x *A2AToRecordRequest) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Data != nil
+}
+
+func (x *A2AToRecordRequest) ClearData() {
+	x.xxx_hidden_Data = nil
+}
+
+type A2AToRecordRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The A2A config to be converted to Record object.
+	Data *structpb.Struct
+}
+
+func (b0 A2AToRecordRequest_builder) Build() *A2AToRecordRequest {
+	m0 := &A2AToRecordRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Data = b.Data
+	return m0
+}
+
+type A2AToRecordResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Record *v3.Record             `protobuf:"bytes,1,opt,name=record,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *A2AToRecordResponse) Reset() {
+	*x = A2AToRecordResponse{}
+	mi := &file_translation_v1_translation_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *A2AToRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*A2AToRecordResponse) ProtoMessage() {}
+
+func (x *A2AToRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_v1_translation_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *A2AToRecordResponse) GetRecord() *v3.Record {
+	if x != nil {
+		return x.xxx_hidden_Record
+	}
+	return nil
+}
+
+func (x *A2AToRecordResponse) SetRecord(v *v3.Record) {
+	x.xxx_hidden_Record = v
+}
+
+func (x *A2AToRecordResponse) HasRecord() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Record != nil
+}
+
+func (x *A2AToRecordResponse) ClearRecord() {
+	x.xxx_hidden_Record = nil
+}
+
+type A2AToRecordResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The generated Record object in a structured format.
+	Record *v3.Record
+}
+
+func (b0 A2AToRecordResponse_builder) Build() *A2AToRecordResponse {
+	m0 := &A2AToRecordResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Record = b.Record
+	return m0
+}
+
+var File_translation_v1_translation_service_proto protoreflect.FileDescriptor
+
+const file_translation_v1_translation_service_proto_rawDesc = "" +
+	"\n" +
+	"(translation/v1/translation_service.proto\x12\x0etranslation.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17objects/v3/record.proto\"J\n" +
+	"\x1cRecordToVSCodeCopilotRequest\x12*\n" +
+	"\x06record\x18\x02 \x01(\v2\x12.objects.v3.RecordR\x06record\"L\n" +
+	"\x1dRecordToVSCodeCopilotResponse\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"G\n" +
+	"\x18GHCopilotToRecordRequest\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"G\n" +
+	"\x19GHCopilotToRecordResponse\x12*\n" +
+	"\x06record\x18\x01 \x01(\v2\x12.objects.v3.RecordR\x06record\"@\n" +
+	"\x12RecordToA2ARequest\x12*\n" +
+	"\x06record\x18\x01 \x01(\v2\x12.objects.v3.RecordR\x06record\"B\n" +
+	"\x13RecordToA2AResponse\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"A\n" +
+	"\x12A2AToRecordRequest\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"A\n" +
+	"\x13A2AToRecordResponse\x12*\n" +
+	"\x06record\x18\x01 \x01(\v2\x12.objects.v3.RecordR\x06record2\xa4\x03\n" +
+	"\x12TranslationService\x12t\n" +
+	"\x15RecordToVSCodeCopilot\x12,.translation.v1.RecordToVSCodeCopilotRequest\x1a-.translation.v1.RecordToVSCodeCopilotResponse\x12h\n" +
+	"\x11GHCopilotToRecord\x12(.translation.v1.GHCopilotToRecordRequest\x1a).translation.v1.GHCopilotToRecordResponse\x12V\n" +
+	"\vRecordToA2A\x12\".translation.v1.RecordToA2ARequest\x1a#.translation.v1.RecordToA2AResponse\x12V\n" +
+	"\vA2AToRecord\x12\".translation.v1.A2AToRecordRequest\x1a#.translation.v1.A2AToRecordResponseBRZPbuf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1;translationv1b\x06proto3"
+
+var file_translation_v1_translation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_translation_v1_translation_service_proto_goTypes = []any{
+	(*RecordToVSCodeCopilotRequest)(nil),  // 0: translation.v1.RecordToVSCodeCopilotRequest
+	(*RecordToVSCodeCopilotResponse)(nil), // 1: translation.v1.RecordToVSCodeCopilotResponse
+	(*GHCopilotToRecordRequest)(nil),      // 2: translation.v1.GHCopilotToRecordRequest
+	(*GHCopilotToRecordResponse)(nil),     // 3: translation.v1.GHCopilotToRecordResponse
+	(*RecordToA2ARequest)(nil),            // 4: translation.v1.RecordToA2ARequest
+	(*RecordToA2AResponse)(nil),           // 5: translation.v1.RecordToA2AResponse
+	(*A2AToRecordRequest)(nil),            // 6: translation.v1.A2AToRecordRequest
+	(*A2AToRecordResponse)(nil),           // 7: translation.v1.A2AToRecordResponse
+	(*v3.Record)(nil),                     // 8: objects.v3.Record
+	(*structpb.Struct)(nil),               // 9: google.protobuf.Struct
+}
+var file_translation_v1_translation_service_proto_depIdxs = []int32{
+	8,  // 0: translation.v1.RecordToVSCodeCopilotRequest.record:type_name -> objects.v3.Record
+	9,  // 1: translation.v1.RecordToVSCodeCopilotResponse.data:type_name -> google.protobuf.Struct
+	9,  // 2: translation.v1.GHCopilotToRecordRequest.data:type_name -> google.protobuf.Struct
+	8,  // 3: translation.v1.GHCopilotToRecordResponse.record:type_name -> objects.v3.Record
+	8,  // 4: translation.v1.RecordToA2ARequest.record:type_name -> objects.v3.Record
+	9,  // 5: translation.v1.RecordToA2AResponse.data:type_name -> google.protobuf.Struct
+	9,  // 6: translation.v1.A2AToRecordRequest.data:type_name -> google.protobuf.Struct
+	8,  // 7: translation.v1.A2AToRecordResponse.record:type_name -> objects.v3.Record
+	0,  // 8: translation.v1.TranslationService.RecordToVSCodeCopilot:input_type -> translation.v1.RecordToVSCodeCopilotRequest
+	2,  // 9: translation.v1.TranslationService.GHCopilotToRecord:input_type -> translation.v1.GHCopilotToRecordRequest
+	4,  // 10: translation.v1.TranslationService.RecordToA2A:input_type -> translation.v1.RecordToA2ARequest
+	6,  // 11: translation.v1.TranslationService.A2AToRecord:input_type -> translation.v1.A2AToRecordRequest
+	1,  // 12: translation.v1.TranslationService.RecordToVSCodeCopilot:output_type -> translation.v1.RecordToVSCodeCopilotResponse
+	3,  // 13: translation.v1.TranslationService.GHCopilotToRecord:output_type -> translation.v1.GHCopilotToRecordResponse
+	5,  // 14: translation.v1.TranslationService.RecordToA2A:output_type -> translation.v1.RecordToA2AResponse
+	7,  // 15: translation.v1.TranslationService.A2AToRecord:output_type -> translation.v1.A2AToRecordResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_translation_v1_translation_service_proto_init() }
+func file_translation_v1_translation_service_proto_init() {
+	if File_translation_v1_translation_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_translation_v1_translation_service_proto_rawDesc), len(file_translation_v1_translation_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_translation_v1_translation_service_proto_goTypes,
+		DependencyIndexes: file_translation_v1_translation_service_proto_depIdxs,
+		MessageInfos:      file_translation_v1_translation_service_proto_msgTypes,
+	}.Build()
+	File_translation_v1_translation_service_proto = out.File
+	file_translation_v1_translation_service_proto_goTypes = nil
+	file_translation_v1_translation_service_proto_depIdxs = nil
+}
@@ -0,0 +1,1224 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: validation/v1/validation_service.proto
+
+//go:build !protoopaque
+
+package validationv1
+
+import (
+	v3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DocumentFormat defines the encoding of a raw Record document.
+type DocumentFormat int32
+
+const (
+	// Detect the format from the document content.
+	DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED DocumentFormat = 0
+	// The document is encoded as JSON.
+	DocumentFormat_DOCUMENT_FORMAT_JSON DocumentFormat = 1
+	// The document is encoded as YAML.
+	DocumentFormat_DOCUMENT_FORMAT_YAML DocumentFormat = 2
+)
+
+// Enum value maps for DocumentFormat.
+var (
+	DocumentFormat_name = map[int32]string{
+		0: "DOCUMENT_FORMAT_UNSPECIFIED",
+		1: "DOCUMENT_FORMAT_JSON",
+		2: "DOCUMENT_FORMAT_YAML",
+	}
+	DocumentFormat_value = map[string]int32{
+		"DOCUMENT_FORMAT_UNSPECIFIED": 0,
+		"DOCUMENT_FORMAT_JSON":        1,
+		"DOCUMENT_FORMAT_YAML":        2,
+	}
+)
+
+func (x DocumentFormat) Enum() *DocumentFormat {
+	p := new(DocumentFormat)
+	*p = x
+	return p
+}
+
+func (x DocumentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_validation_v1_validation_service_proto_enumTypes[0].Descriptor()
+}
+
+func (DocumentFormat) Type() protoreflect.EnumType {
+	return &file_validation_v1_validation_service_proto_enumTypes[0]
+}
+
+func (x DocumentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type ValidateRecordRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// The Record object to be validated.
+	Record *v3.Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// Optional schema URL to validate against instead of embedded schemas.
+	// If provided, the validation service will fetch and validate against this schema URL.
+	// If empty, validation uses the embedded JSON schemas in the binary.
+	SchemaUrl string `protobuf:"bytes,2,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	// Whether to detect the schema version from the structure of the record
+	// when its schema_version is empty or not known to the service.
+	// The version used for validation is reported in the response.
+	DetectSchemaVersion bool `protobuf:"varint,3,opt,name=detect_schema_version,json=detectSchemaVersion,proto3" json:"detect_schema_version,omitempty"`
+	// Whether to include a SARIF 2.1.0 log of the validation issues in the response.
+	IncludeSarif  bool `protobuf:"varint,4,opt,name=include_sarif,json=includeSarif,proto3" json:"include_sarif,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRecordRequest) Reset() {
+	*x = ValidateRecordRequest{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecordRequest) ProtoMessage() {}
+
+func (x *ValidateRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidateRecordRequest) GetRecord() *v3.Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ValidateRecordRequest) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+func (x *ValidateRecordRequest) GetDetectSchemaVersion() bool {
+	if x != nil {
+		return x.DetectSchemaVersion
+	}
+	return false
+}
+
+func (x *ValidateRecordRequest) GetIncludeSarif() bool {
+	if x != nil {
+		return x.IncludeSarif
+	}
+	return false
+}
+
+func (x *ValidateRecordRequest) SetRecord(v *v3.Record) {
+	x.Record = v
+}
+
+func (x *ValidateRecordRequest) SetSchemaUrl(v string) {
+	x.SchemaUrl = v
+}
+
+func (x *ValidateRecordRequest) SetDetectSchemaVersion(v bool) {
+	x.DetectSchemaVersion = v
+}
+
+func (x *ValidateRecordRequest) SetIncludeSarif(v bool) {
+	x.IncludeSarif = v
+}
+
+func (x *ValidateRecordRequest) HasRecord() bool {
+	if x == nil {
+		return false
+	}
+	return x.Record != nil
+}
+
+func (x *ValidateRecordRequest) ClearRecord() {
+	x.Record = nil
+}
+
+type ValidateRecordRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The Record object to be validated.
+	Record *v3.Record
+	// Optional schema URL to validate against instead of embedded schemas.
+	// If provided, the validation service will fetch and validate against this schema URL.
+	// If empty, validation uses the embedded JSON schemas in the binary.
+	SchemaUrl string
+	// Whether to detect the schema version from the structure of the record
+	// when its schema_version is empty or not known to the service.
+	// The version used for validation is reported in the response.
+	DetectSchemaVersion bool
+	// Whether to include a SARIF 2.1.0 log of the validation issues in the response.
+	IncludeSarif bool
+}
+
+func (b0 ValidateRecordRequest_builder) Build() *ValidateRecordRequest {
+	m0 := &ValidateRecordRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Record = b.Record
+	x.SchemaUrl = b.SchemaUrl
+	x.DetectSchemaVersion = b.DetectSchemaVersion
+	x.IncludeSarif = b.IncludeSarif
+	return m0
+}
+
+type ValidateRecordResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Whether the Record is valid.
+	IsValid bool `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	// A list of validation errors, if any.
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// The version of the embedded schema the Record was validated against.
+	// Empty if the Record was validated against a schema URL.
+	SchemaVersion string `protobuf:"bytes,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// A list of warnings, eg. when the schema version was detected or a compatible version was used instead.
+	Warnings []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// The validation issues as a JSON encoded SARIF 2.1.0 log, if requested with include_sarif.
+	Sarif         string `protobuf:"bytes,5,opt,name=sarif,proto3" json:"sarif,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRecordResponse) Reset() {
+	*x = ValidateRecordResponse{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecordResponse) ProtoMessage() {}
+
+func (x *ValidateRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidateRecordResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateRecordResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateRecordResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *ValidateRecordResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ValidateRecordResponse) GetSarif() string {
+	if x != nil {
+		return x.Sarif
+	}
+	return ""
+}
+
+func (x *ValidateRecordResponse) SetIsValid(v bool) {
+	x.IsValid = v
+}
+
+func (x *ValidateRecordResponse) SetErrors(v []string) {
+	x.Errors = v
+}
+
+func (x *ValidateRecordResponse) SetSchemaVersion(v string) {
+	x.SchemaVersion = v
+}
+
+func (x *ValidateRecordResponse) SetWarnings(v []string) {
+	x.Warnings = v
+}
+
+func (x *ValidateRecordResponse) SetSarif(v string) {
+	x.Sarif = v
+}
+
+type ValidateRecordResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Whether the Record is valid.
+	IsValid bool
+	// A list of validation errors, if any.
+	Errors []string
+	// The version of the embedded schema the Record was validated against.
+	// Empty if the Record was validated against a schema URL.
+	SchemaVersion string
+	// A list of warnings, eg. when the schema version was detected or a compatible version was used instead.
+	Warnings []string
+	// The validation issues as a JSON encoded SARIF 2.1.0 log, if requested with include_sarif.
+	Sarif string
+}
+
+func (b0 ValidateRecordResponse_builder) Build() *ValidateRecordResponse {
+	m0 := &ValidateRecordResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.IsValid = b.IsValid
+	x.Errors = b.Errors
+	x.SchemaVersion = b.SchemaVersion
+	x.Warnings = b.Warnings
+	x.Sarif = b.Sarif
+	return m0
+}
+
+type ValidateRecordStreamRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// The Record object to be validated.
+	Record *v3.Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// Optional schema URL to validate against instead of embedded schemas.
+	// If provided, the validation service will fetch and validate against this schema URL.
+	// If empty, validation uses the embedded JSON schemas in the binary.
+	SchemaUrl string `protobuf:"bytes,2,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	// Whether to detect the schema version from the structure of the record
+	// when its schema_version is empty or not known to the service.
+	// The version used for validation is reported in the response.
+	DetectSchemaVersion bool `protobuf:"varint,3,opt,name=detect_schema_version,json=detectSchemaVersion,proto3" json:"detect_schema_version,omitempty"`
+	// Optional client-supplied identifier of the item, echoed back in the matching response.
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// Whether responses can be sent as soon as they are ready instead of in input order.
+	// Only read from the first item of the stream. Unordered streams require a correlation_id on every item
+	// to match responses to requests.
+	Unordered     bool `protobuf:"varint,5,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRecordStreamRequest) Reset() {
+	*x = ValidateRecordStreamRequest{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRecordStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecordStreamRequest) ProtoMessage() {}
+
+func (x *ValidateRecordStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidateRecordStreamRequest) GetRecord() *v3.Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ValidateRecordStreamRequest) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+func (x *ValidateRecordStreamRequest) GetDetectSchemaVersion() bool {
+	if x != nil {
+		return x.DetectSchemaVersion
+	}
+	return false
+}
+
+func (x *ValidateRecordStreamRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *ValidateRecordStreamRequest) GetUnordered() bool {
+	if x != nil {
+		return x.Unordered
+	}
+	return false
+}
+
+func (x *ValidateRecordStreamRequest) SetRecord(v *v3.Record) {
+	x.Record = v
+}
+
+func (x *ValidateRecordStreamRequest) SetSchemaUrl(v string) {
+	x.SchemaUrl = v
+}
+
+func (x *ValidateRecordStreamRequest) SetDetectSchemaVersion(v bool) {
+	x.DetectSchemaVersion = v
+}
+
+func (x *ValidateRecordStreamRequest) SetCorrelationId(v string) {
+	x.CorrelationId = v
+}
+
+func (x *ValidateRecordStreamRequest) SetUnordered(v bool) {
+	x.Unordered = v
+}
+
+func (x *ValidateRecordStreamRequest) HasRecord() bool {
+	if x == nil {
+		return false
+	}
+	return x.Record != nil
+}
+
+func (x *ValidateRecordStreamRequest) ClearRecord() {
+	x.Record = nil
+}
+
+type ValidateRecordStreamRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The Record object to be validated.
+	Record *v3.Record
+	// Optional schema URL to validate against instead of embedded schemas.
+	// If provided, the validation service will fetch and validate against this schema URL.
+	// If empty, validation uses the embedded JSON schemas in the binary.
+	SchemaUrl string
+	// Whether to detect the schema version from the structure of the record
+	// when its schema_version is empty or not known to the service.
+	// The version used for validation is reported in the response.
+	DetectSchemaVersion bool
+	// Optional client-supplied identifier of the item, echoed back in the matching response.
+	CorrelationId string
+	// Whether responses can be sent as soon as they are ready instead of in input order.
+	// Only read from the first item of the stream. Unordered streams require a correlation_id on every item
+	// to match responses to requests.
+	Unordered bool
+}
+
+func (b0 ValidateRecordStreamRequest_builder) Build() *ValidateRecordStreamRequest {
+	m0 := &ValidateRecordStreamRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Record = b.Record
+	x.SchemaUrl = b.SchemaUrl
+	x.DetectSchemaVersion = b.DetectSchemaVersion
+	x.CorrelationId = b.CorrelationId
+	x.Unordered = b.Unordered
+	return m0
+}
+
+type ValidateRecordStreamResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Whether the Record is valid.
+	IsValid bool `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	// A list of validation errors, if any.
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// The version of the embedded schema the Record was validated against.
+	// Empty if the Record was validated against a schema URL.
+	SchemaVersion string `protobuf:"bytes,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// A list of warnings, eg. when the schema version was detected or a compatible version was used instead.
+	Warnings []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// The correlation_id of the matching request item.
+	CorrelationId string `protobuf:"bytes,5,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// The error that prevented the item from being validated, if any.
+	// When set, is_valid is false and errors is empty.
+	Error         *ValidateRecordStreamError `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRecordStreamResponse) Reset() {
+	*x = ValidateRecordStreamResponse{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRecordStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecordStreamResponse) ProtoMessage() {}
+
+func (x *ValidateRecordStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidateRecordStreamResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateRecordStreamResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateRecordStreamResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *ValidateRecordStreamResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ValidateRecordStreamResponse) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *ValidateRecordStreamResponse) GetError() *ValidateRecordStreamError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ValidateRecordStreamResponse) SetIsValid(v bool) {
+	x.IsValid = v
+}
+
+func (x *ValidateRecordStreamResponse) SetErrors(v []string) {
+	x.Errors = v
+}
+
+func (x *ValidateRecordStreamResponse) SetSchemaVersion(v string) {
+	x.SchemaVersion = v
+}
+
+func (x *ValidateRecordStreamResponse) SetWarnings(v []string) {
+	x.Warnings = v
+}
+
+func (x *ValidateRecordStreamResponse) SetCorrelationId(v string) {
+	x.CorrelationId = v
+}
+
+func (x *ValidateRecordStreamResponse) SetError(v *ValidateRecordStreamError) {
+	x.Error = v
+}
+
+func (x *ValidateRecordStreamResponse) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.Error != nil
+}
+
+func (x *ValidateRecordStreamResponse) ClearError() {
+	x.Error = nil
+}
+
+type ValidateRecordStreamResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Whether the Record is valid.
+	IsValid bool
+	// A list of validation errors, if any.
+	Errors []string
+	// The version of the embedded schema the Record was validated against.
+	// Empty if the Record was validated against a schema URL.
+	SchemaVersion string
+	// A list of warnings, eg. when the schema version was detected or a compatible version was used instead.
+	Warnings []string
+	// The correlation_id of the matching request item.
+	CorrelationId string
+	// The error that prevented the item from being validated, if any.
+	// When set, is_valid is false and errors is empty.
+	Error *ValidateRecordStreamError
+}
+
+func (b0 ValidateRecordStreamResponse_builder) Build() *ValidateRecordStreamResponse {
+	m0 := &ValidateRecordStreamResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.IsValid = b.IsValid
+	x.Errors = b.Errors
+	x.SchemaVersion = b.SchemaVersion
+	x.Warnings = b.Warnings
+	x.CorrelationId = b.CorrelationId
+	x.Error = b.Error
+	return m0
+}
+
+type ValidateRecordStreamError struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// The gRPC status code of the error, as defined by google.rpc.Code.
+	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// A developer-facing description of the error.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRecordStreamError) Reset() {
+	*x = ValidateRecordStreamError{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRecordStreamError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecordStreamError) ProtoMessage() {}
+
+func (x *ValidateRecordStreamError) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidateRecordStreamError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ValidateRecordStreamError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateRecordStreamError) SetCode(v int32) {
+	x.Code = v
+}
+
+func (x *ValidateRecordStreamError) SetMessage(v string) {
+	x.Message = v
+}
+
+type ValidateRecordStreamError_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The gRPC status code of the error, as defined by google.rpc.Code.
+	Code int32
+	// A developer-facing description of the error.
+	Message string
+}
+
+func (b0 ValidateRecordStreamError_builder) Build() *ValidateRecordStreamError {
+	m0 := &ValidateRecordStreamError{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Code = b.Code
+	x.Message = b.Message
+	return m0
+}
+
+type ValidateRecordDocumentRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// The raw Record document to be validated.
+	Document []byte `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	// The encoding of the document.
+	// If unspecified, the format is detected from the document content.
+	Format DocumentFormat `protobuf:"varint,2,opt,name=format,proto3,enum=validation.v1.DocumentFormat" json:"format,omitempty"`
+	// Optional schema URL to validate against instead of embedded schemas.
+	// If provided, the validation service will fetch and validate against this schema URL.
+	// If empty, validation uses the embedded JSON schema matching the schema_version of the document.
+	SchemaUrl string `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	// Whether to detect the schema version from the structure of the document
+	// when its schema_version is empty or not known to the service.
+	// The version used for validation is reported in the response.
+	DetectSchemaVersion bool `protobuf:"varint,4,opt,name=detect_schema_version,json=detectSchemaVersion,proto3" json:"detect_schema_version,omitempty"`
+	// Whether to include a SARIF 2.1.0 log of the validation issues in the response.
+	IncludeSarif bool `protobuf:"varint,5,opt,name=include_sarif,json=includeSarif,proto3" json:"include_sarif,omitempty"`
+	// Optional URI of the document, eg. its path in a repository, used as the artifact location in the SARIF log.
+	DocumentUri   string `protobuf:"bytes,6,opt,name=document_uri,json=documentUri,proto3" json:"document_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRecordDocumentRequest) Reset() {
+	*x = ValidateRecordDocumentRequest{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRecordDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecordDocumentRequest) ProtoMessage() {}
+
+func (x *ValidateRecordDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidateRecordDocumentRequest) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ValidateRecordDocumentRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *ValidateRecordDocumentRequest) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+func (x *ValidateRecordDocumentRequest) GetDetectSchemaVersion() bool {
+	if x != nil {
+		return x.DetectSchemaVersion
+	}
+	return false
+}
+
+func (x *ValidateRecordDocumentRequest) GetIncludeSarif() bool {
+	if x != nil {
+		return x.IncludeSarif
+	}
+	return false
+}
+
+func (x *ValidateRecordDocumentRequest) GetDocumentUri() string {
+	if x != nil {
+		return x.DocumentUri
+	}
+	return ""
+}
+
+func (x *ValidateRecordDocumentRequest) SetDocument(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Document = v
+}
+
+func (x *ValidateRecordDocumentRequest) SetFormat(v DocumentFormat) {
+	x.Format = v
+}
+
+func (x *ValidateRecordDocumentRequest) SetSchemaUrl(v string) {
+	x.SchemaUrl = v
+}
+
+func (x *ValidateRecordDocumentRequest) SetDetectSchemaVersion(v bool) {
+	x.DetectSchemaVersion = v
+}
+
+func (x *ValidateRecordDocumentRequest) SetIncludeSarif(v bool) {
+	x.IncludeSarif = v
+}
+
+func (x *ValidateRecordDocumentRequest) SetDocumentUri(v string) {
+	x.DocumentUri = v
+}
+
+type ValidateRecordDocumentRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The raw Record document to be validated.
+	Document []byte
+	// The encoding of the document.
+	// If unspecified, the format is detected from the document content.
+	Format DocumentFormat
+	// Optional schema URL to validate against instead of embedded schemas.
+	// If provided, the validation service will fetch and validate against this schema URL.
+	// If empty, validation uses the embedded JSON schema matching the schema_version of the document.
+	SchemaUrl string
+	// Whether to detect the schema version from the structure of the document
+	// when its schema_version is empty or not known to the service.
+	// The version used for validation is reported in the response.
+	DetectSchemaVersion bool
+	// Whether to include a SARIF 2.1.0 log of the validation issues in the response.
+	IncludeSarif bool
+	// Optional URI of the document, eg. its path in a repository, used as the artifact location in the SARIF log.
+	DocumentUri string
+}
+
+func (b0 ValidateRecordDocumentRequest_builder) Build() *ValidateRecordDocumentRequest {
+	m0 := &ValidateRecordDocumentRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Document = b.Document
+	x.Format = b.Format
+	x.SchemaUrl = b.SchemaUrl
+	x.DetectSchemaVersion = b.DetectSchemaVersion
+	x.IncludeSarif = b.IncludeSarif
+	x.DocumentUri = b.DocumentUri
+	return m0
+}
+
+type ValidateRecordDocumentResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Whether the Record document is valid.
+	IsValid bool `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	// A list of validation issues, if any.
+	Issues []*ValidationIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	// The version of the embedded schema the document was validated against.
+	// Empty if the document was validated against a schema URL.
+	SchemaVersion string `protobuf:"bytes,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// A list of warnings, eg. when the schema version was detected or a compatible version was used instead.
+	Warnings []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// The validation issues as a JSON encoded SARIF 2.1.0 log, if requested with include_sarif.
+	Sarif         string `protobuf:"bytes,5,opt,name=sarif,proto3" json:"sarif,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRecordDocumentResponse) Reset() {
+	*x = ValidateRecordDocumentResponse{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRecordDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRecordDocumentResponse) ProtoMessage() {}
+
+func (x *ValidateRecordDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidateRecordDocumentResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateRecordDocumentResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ValidateRecordDocumentResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *ValidateRecordDocumentResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ValidateRecordDocumentResponse) GetSarif() string {
+	if x != nil {
+		return x.Sarif
+	}
+	return ""
+}
+
+func (x *ValidateRecordDocumentResponse) SetIsValid(v bool) {
+	x.IsValid = v
+}
+
+func (x *ValidateRecordDocumentResponse) SetIssues(v []*ValidationIssue) {
+	x.Issues = v
+}
+
+func (x *ValidateRecordDocumentResponse) SetSchemaVersion(v string) {
+	x.SchemaVersion = v
+}
+
+func (x *ValidateRecordDocumentResponse) SetWarnings(v []string) {
+	x.Warnings = v
+}
+
+func (x *ValidateRecordDocumentResponse) SetSarif(v string) {
+	x.Sarif = v
+}
+
+type ValidateRecordDocumentResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Whether the Record document is valid.
+	IsValid bool
+	// A list of validation issues, if any.
+	Issues []*ValidationIssue
+	// The version of the embedded schema the document was validated against.
+	// Empty if the document was validated against a schema URL.
+	SchemaVersion string
+	// A list of warnings, eg. when the schema version was detected or a compatible version was used instead.
+	Warnings []string
+	// The validation issues as a JSON encoded SARIF 2.1.0 log, if requested with include_sarif.
+	Sarif string
+}
+
+func (b0 ValidateRecordDocumentResponse_builder) Build() *ValidateRecordDocumentResponse {
+	m0 := &ValidateRecordDocumentResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.IsValid = b.IsValid
+	x.Issues = b.Issues
+	x.SchemaVersion = b.SchemaVersion
+	x.Warnings = b.Warnings
+	x.Sarif = b.Sarif
+	return m0
+}
+
+type ValidationIssue struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// A human-readable description of the issue.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// The location of the offending value as a JSON pointer, eg. "/locators/0/type".
+	// An empty path refers to the document root.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// The JSON schema keyword that failed, eg. "required", or "syntax" if the document could not be parsed.
+	Keyword string `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// The 1-based line of the offending value in the original document, or 0 if unknown.
+	Line uint32 `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	// The 1-based column of the offending value in the original document, or 0 if unknown.
+	Column        uint32 `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationIssue) Reset() {
+	*x = ValidationIssue{}
+	mi := &file_validation_v1_validation_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationIssue) ProtoMessage() {}
+
+func (x *ValidationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_validation_v1_validation_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ValidationIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ValidationIssue) GetColumn() uint32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *ValidationIssue) SetMessage(v string) {
+	x.Message = v
+}
+
+func (x *ValidationIssue) SetPath(v string) {
+	x.Path = v
+}
+
+func (x *ValidationIssue) SetKeyword(v string) {
+	x.Keyword = v
+}
+
+func (x *ValidationIssue) SetLine(v uint32) {
+	x.Line = v
+}
+
+func (x *ValidationIssue) SetColumn(v uint32) {
+	x.Column = v
+}
+
+type ValidationIssue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// A human-readable description of the issue.
+	Message string
+	// The location of the offending value as a JSON pointer, eg. "/locators/0/type".
+	// An empty path refers to the document root.
+	Path string
+	// The JSON schema keyword that failed, eg. "required", or "syntax" if the document could not be parsed.
+	Keyword string
+	// The 1-based line of the offending value in the original document, or 0 if unknown.
+	Line uint32
+	// The 1-based column of the offending value in the original document, or 0 if unknown.
+	Column uint32
+}
+
+func (b0 ValidationIssue_builder) Build() *ValidationIssue {
+	m0 := &ValidationIssue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Message = b.Message
+	x.Path = b.Path
+	x.Keyword = b.Keyword
+	x.Line = b.Line
+	x.Column = b.Column
+	return m0
+}
+
+var File_validation_v1_validation_service_proto protoreflect.FileDescriptor
+
+const file_validation_v1_validation_service_proto_rawDesc = "" +
+	"\n" +
+	"&validation/v1/validation_service.proto\x12\rvalidation.v1\x1a\x17objects/v3/record.proto\"\xbb\x01\n" +
+	"\x15ValidateRecordRequest\x12*\n" +
+	"\x06record\x18\x01 \x01(\v2\x12.objects.v3.RecordR\x06record\x12\x1d\n" +
+	"\n" +
+	"schema_url\x18\x02 \x01(\tR\tschemaUrl\x122\n" +
+	"\x15detect_schema_version\x18\x03 \x01(\bR\x13detectSchemaVersion\x12#\n" +
+	"\rinclude_sarif\x18\x04 \x01(\bR\fincludeSarif\"\xa4\x01\n" +
+	"\x16ValidateRecordResponse\x12\x19\n" +
+	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\tR\rschemaVersion\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05sarif\x18\x05 \x01(\tR\x05sarif\"\xe1\x01\n" +
+	"\x1bValidateRecordStreamRequest\x12*\n" +
+	"\x06record\x18\x01 \x01(\v2\x12.objects.v3.RecordR\x06record\x12\x1d\n" +
+	"\n" +
+	"schema_url\x18\x02 \x01(\tR\tschemaUrl\x122\n" +
+	"\x15detect_schema_version\x18\x03 \x01(\bR\x13detectSchemaVersion\x12%\n" +
+	"\x0ecorrelation_id\x18\x04 \x01(\tR\rcorrelationId\x12\x1c\n" +
+	"\tunordered\x18\x05 \x01(\bR\tunordered\"\xfb\x01\n" +
+	"\x1cValidateRecordStreamResponse\x12\x19\n" +
+	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\tR\rschemaVersion\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\x12%\n" +
+	"\x0ecorrelation_id\x18\x05 \x01(\tR\rcorrelationId\x12>\n" +
+	"\x05error\x18\x06 \x01(\v2(.validation.v1.ValidateRecordStreamErrorR\x05error\"I\n" +
+	"\x19ValidateRecordStreamError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8d\x02\n" +
+	"\x1dValidateRecordDocumentRequest\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\fR\bdocument\x125\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1d.validation.v1.DocumentFormatR\x06format\x12\x1d\n" +
+	"\n" +
+	"schema_url\x18\x03 \x01(\tR\tschemaUrl\x122\n" +
+	"\x15detect_schema_version\x18\x04 \x01(\bR\x13detectSchemaVersion\x12#\n" +
+	"\rinclude_sarif\x18\x05 \x01(\bR\fincludeSarif\x12!\n" +
+	"\fdocument_uri\x18\x06 \x01(\tR\vdocumentUri\"\xcc\x01\n" +
+	"\x1eValidateRecordDocumentResponse\x12\x19\n" +
+	"\bis_valid\x18\x01 \x01(\bR\aisValid\x126\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1e.validation.v1.ValidationIssueR\x06issues\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\tR\rschemaVersion\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05sarif\x18\x05 \x01(\tR\x05sarif\"\x85\x01\n" +
+	"\x0fValidationIssue\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04line\x18\x04 \x01(\rR\x04line\x12\x16\n" +
+	"\x06column\x18\x05 \x01(\rR\x06column*e\n" +
+	"\x0eDocumentFormat\x12\x1f\n" +
+	"\x1bDOCUMENT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DOCUMENT_FORMAT_JSON\x10\x01\x12\x18\n" +
+	"\x14DOCUMENT_FORMAT_YAML\x10\x022\xde\x02\n" +
+	"\x11ValidationService\x12]\n" +
+	"\x0eValidateRecord\x12$.validation.v1.ValidateRecordRequest\x1a%.validation.v1.ValidateRecordResponse\x12s\n" +
+	"\x14ValidateRecordStream\x12*.validation.v1.ValidateRecordStreamRequest\x1a+.validation.v1.ValidateRecordStreamResponse(\x010\x01\x12u\n" +
+	"\x16ValidateRecordDocument\x12,.validation.v1.ValidateRecordDocumentRequest\x1a-.validation.v1.ValidateRecordDocumentResponseBPZNbuf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1;validationv1b\x06proto3"
+
+var file_validation_v1_validation_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_validation_v1_validation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_validation_v1_validation_service_proto_goTypes = []any{
+	(DocumentFormat)(0),                    // 0: validation.v1.DocumentFormat
+	(*ValidateRecordRequest)(nil),          // 1: validation.v1.ValidateRecordRequest
+	(*ValidateRecordResponse)(nil),         // 2: validation.v1.ValidateRecordResponse
+	(*ValidateRecordStreamRequest)(nil),    // 3: validation.v1.ValidateRecordStreamRequest
+	(*ValidateRecordStreamResponse)(nil),   // 4: validation.v1.ValidateRecordStreamResponse
+	(*ValidateRecordStreamError)(nil),      // 5: validation.v1.ValidateRecordStreamError
+	(*ValidateRecordDocumentRequest)(nil),  // 6: validation.v1.ValidateRecordDocumentRequest
+	(*ValidateRecordDocumentResponse)(nil), // 7: validation.v1.ValidateRecordDocumentResponse
+	(*ValidationIssue)(nil),                // 8: validation.v1.ValidationIssue
+	(*v3.Record)(nil),                      // 9: objects.v3.Record
+}
+var file_validation_v1_validation_service_proto_depIdxs = []int32{
+	9, // 0: validation.v1.ValidateRecordRequest.record:type_name -> objects.v3.Record
+	9, // 1: validation.v1.ValidateRecordStreamRequest.record:type_name -> objects.v3.Record
+	5, // 2: validation.v1.ValidateRecordStreamResponse.error:type_name -> validation.v1.ValidateRecordStreamError
+	0, // 3: validation.v1.ValidateRecordDocumentRequest.format:type_name -> validation.v1.DocumentFormat
+	8, // 4: validation.v1.ValidateRecordDocumentResponse.issues:type_name -> validation.v1.ValidationIssue
+	1, // 5: validation.v1.ValidationService.ValidateRecord:input_type -> validation.v1.ValidateRecordRequest
+	3, // 6: validation.v1.ValidationService.ValidateRecordStream:input_type -> validation.v1.ValidateRecordStreamRequest
+	6, // 7: validation.v1.ValidationService.ValidateRecordDocument:input_type -> validation.v1.ValidateRecordDocumentRequest
+	2, // 8: validation.v1.ValidationService.ValidateRecord:output_type -> validation.v1.ValidateRecordResponse
+	4, // 9: validation.v1.ValidationService.ValidateRecordStream:output_type -> validation.v1.ValidateRecordStreamResponse
+	7, // 10: validation.v1.ValidationService.ValidateRecordDocument:output_type -> validation.v1.ValidateRecordDocumentResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_validation_v1_validation_service_proto_init() }
+func file_validation_v1_validation_service_proto_init() {
+	if File_validation_v1_validation_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_validation_v1_validation_service_proto_rawDesc), len(file_validation_v1_validation_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_validation_v1_validation_service_proto_goTypes,
+		DependencyIndexes: file_validation_v1_validation_service_proto_depIdxs,
+		EnumInfos:         file_validation_v1_validation_service_proto_enumTypes,
+		MessageInfos:      file_validation_v1_validation_service_proto_msgTypes,
+	}.Build()
+	File_validation_v1_validation_service_proto = out.File
+	file_validation_v1_validation_service_proto_goTypes = nil
+	file_validation_v1_validation_service_proto_depIdxs = nil
+}
@@ -5,44 +5,53 @@ package config
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/mitchellh/mapstructure"
-	"github.com/spf13/viper"
+	commonconfig "github.com/agntcy/oasf-sdk/common/config"
+	"github.com/agntcy/oasf-sdk/common/server"
+	"github.com/spf13/pflag"
 )
 
 const (
-	DefaultEnvPrefix     = "TRANSLATION_SERVER"
-	DefaultListenAddress = "0.0.0.0:31234"
+	DefaultEnvPrefix            = "TRANSLATION_SERVER"
+	DefaultListenAddress        = "0.0.0.0:31234"
+	DefaultHTTPListenAddress    = "0.0.0.0:31244"
+	DefaultMetricsListenAddress = "0.0.0.0:31254"
+	DefaultServiceName          = "oasf-sdk-translation"
 )
 
 type Config struct {
-	ListenAddress string `json:"listen_address,omitempty" mapstructure:"listen_address"`
+	server.Config `mapstructure:",squash"`
 }
 
-func LoadConfig() (*Config, error) {
-	v := viper.NewWithOptions(
-		viper.KeyDelimiter("."),
-		viper.EnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_")),
-	)
-
-	v.SetEnvPrefix(DefaultEnvPrefix)
-	v.AllowEmptyEnv(true)
-	v.AutomaticEnv()
-
-	_ = v.BindEnv("listen_address")
-	v.SetDefault("listen_address", DefaultListenAddress)
-
-	decodeHooks := mapstructure.ComposeDecodeHookFunc(
-		mapstructure.TextUnmarshallerHookFunc(),
-		mapstructure.StringToTimeDurationHookFunc(),
-		mapstructure.StringToSliceHookFunc(","),
-	)
-
+// LoadConfig loads the configuration from the flags added by AddFlags, if not nil,
+// the environment and the configuration file, and validates it.
+func LoadConfig(flags *pflag.FlagSet) (*Config, error) {
 	config := &Config{}
-	if err := v.Unmarshal(config, viper.DecodeHook(decodeHooks)); err != nil {
-		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	if err := commonconfig.Load(commonconfig.NewViper(DefaultEnvPrefix), Options(), flags, config); err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
 	return config, nil
 }
+
+// AddFlags adds the flags of the configuration options to flags.
+func AddFlags(flags *pflag.FlagSet) {
+	commonconfig.AddFlags(flags, Options())
+}
+
+// Options returns the options of the translation server.
+func Options() []commonconfig.Option {
+	return server.Options("", server.Config{
+		ListenAddress:        DefaultListenAddress,
+		HTTPListenAddress:    DefaultHTTPListenAddress,
+		MetricsListenAddress: DefaultMetricsListenAddress,
+		Tracing: server.TracingConfig{
+			SampleRatio: server.DefaultSampleRatio,
+			ServiceName: DefaultServiceName,
+		},
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"errors"
+
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
+	"github.com/agntcy/oasf-sdk/translation/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// extensionsField is the request field holding the extensions of the translated record.
+const extensionsField = "record.extensions"
+
+// errorStatus converts a translation service error into a status error with the matching code and error details.
+func errorStatus(err error) error {
+	var (
+		notFoundErr       *service.ExtensionNotFoundError
+		invalidErr        *service.InvalidExtensionError
+		formatNotFoundErr *service.FormatNotFoundError
+	)
+
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.As(err, &notFoundErr):
+		return commonserver.ErrorStatus(codes.InvalidArgument, err, service.ReasonExtensionNotFound,
+			map[string]string{"extension": notFoundErr.Extension},
+			commonserver.FieldViolation(extensionsField, notFoundErr.Error()),
+		)
+	case errors.As(err, &invalidErr):
+		return commonserver.ErrorStatus(codes.InvalidArgument, err, service.ReasonInvalidExtension,
+			map[string]string{"extension": invalidErr.Extension},
+			commonserver.FieldViolation(extensionsField, invalidErr.Error()),
+		)
+	case errors.As(err, &formatNotFoundErr):
+		// The translator of the RPC method was removed from the registry the service was created with.
+		return commonserver.ErrorStatus(codes.Unimplemented, err, service.ReasonFormatNotFound,
+			map[string]string{"format": formatNotFoundErr.Format},
+		)
+	default:
+		return commonserver.ErrorStatus(codes.Internal, err, commonserver.ErrorReasonInternal, nil)
+	}
+}
@@ -6,7 +6,6 @@ package v1
 import (
 	"context"
 	"fmt"
-	"log/slog"
 
 	translationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/translation/v1/translationv1grpc"
 	translationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
@@ -18,30 +17,27 @@ type translationCtrl struct {
 	translationService *service.TranslationService
 }
 
-func NewRoutingController() translationv1grpc.TranslationServiceServer {
+// NewRoutingController creates the gRPC service of a translation service created with the options.
+func NewRoutingController(opts ...service.Option) translationv1grpc.TranslationServiceServer {
 	return &translationCtrl{
 		UnimplementedTranslationServiceServer: translationv1grpc.UnimplementedTranslationServiceServer{},
-		translationService:                    service.NewTranslationService(),
+		translationService:                    service.NewTranslationService(opts...),
 	}
 }
 
-func (t translationCtrl) RecordToVSCodeCopilot(_ context.Context, req *translationv1.RecordToVSCodeCopilotRequest) (*translationv1.RecordToVSCodeCopilotResponse, error) {
-	slog.Info("Received Publish request", "request", req)
-
-	data, err := t.translationService.RecordToVSCodeCopilot(req)
+func (t translationCtrl) RecordToVSCodeCopilot(ctx context.Context, req *translationv1.RecordToVSCodeCopilotRequest) (*translationv1.RecordToVSCodeCopilotResponse, error) {
+	data, err := t.translationService.RecordToVSCodeCopilot(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate VSCodeCopilot config from record: %w", err)
+		return nil, errorStatus(fmt.Errorf("failed to generate VSCodeCopilot config from record: %w", err))
 	}
 
 	return &translationv1.RecordToVSCodeCopilotResponse{Data: data}, nil
 }
 
-func (t translationCtrl) RecordToA2A(_ context.Context, req *translationv1.RecordToA2ARequest) (*translationv1.RecordToA2AResponse, error) {
-	slog.Info("Received RecordToA2A request", "request", req)
-
-	data, err := t.translationService.RecordToA2A(req)
+func (t translationCtrl) RecordToA2A(ctx context.Context, req *translationv1.RecordToA2ARequest) (*translationv1.RecordToA2AResponse, error) {
+	data, err := t.translationService.RecordToA2A(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate A2A card from record: %w", err)
+		return nil, errorStatus(fmt.Errorf("failed to generate A2A card from record: %w", err))
 	}
 
 	return &translationv1.RecordToA2AResponse{Data: data}, nil
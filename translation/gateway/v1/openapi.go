@@ -0,0 +1,14 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import _ "embed"
+
+// OpenAPIPath is the HTTP path the OpenAPI document is served at.
+const OpenAPIPath = "/openapi/translation_service.swagger.json"
+
+// OpenAPI is the OpenAPI v2 document of the TranslationService HTTP/JSON API, generated from the proto definitions.
+//
+//go:embed translation_service.swagger.json
+var OpenAPI []byte
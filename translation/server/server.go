@@ -6,78 +6,44 @@ package server
 import (
 	"context"
 	"fmt"
-	"log/slog"
-	"net"
-	"os"
-	"os/signal"
-	"syscall"
 
 	translationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/translation/v1/translationv1grpc"
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
 	"github.com/agntcy/oasf-sdk/translation/config"
 	controllerv1 "github.com/agntcy/oasf-sdk/translation/controller/v1"
+	gatewayv1 "github.com/agntcy/oasf-sdk/translation/gateway/v1"
+	"github.com/agntcy/oasf-sdk/translation/service"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
 )
 
-type Server struct {
-	cfg        *config.Config
-	grpcServer *grpc.Server
-}
-
 func Run(ctx context.Context, cfg *config.Config) error {
-	server, err := NewServer(ctx, cfg)
+	stopTracing, err := commonserver.SetupTracing(ctx, cfg.Tracing)
 	if err != nil {
-		return fmt.Errorf("failed to create server: %w", err)
+		return fmt.Errorf("failed to set up tracing: %w", err)
 	}
+	defer stopTracing()
 
-	if err := server.start(); err != nil {
-		return fmt.Errorf("failed to start server: %w", err)
-	}
-	defer server.close()
-
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
-
-	select {
-	case <-ctx.Done():
-		return fmt.Errorf("stopping server due to context cancellation: %w", ctx.Err())
-	case sig := <-sigCh:
-		return fmt.Errorf("stopping server due to signal: %v", sig)
-	}
+	return commonserver.Run(ctx, cfg.Config, NewService())
 }
 
-func NewServer(ctx context.Context, cfg *config.Config) (*Server, error) {
-	slog.Info("Creating new server", "config", cfg)
-
-	server := &Server{
-		cfg:        cfg,
-		grpcServer: grpc.NewServer(),
+// NewService creates the translation service, to be served alone or along with other services.
+// The translators of the RPC methods can be replaced with the options.
+func NewService(opts ...service.Option) commonserver.Service {
+	controller := controllerv1.NewRoutingController(opts...)
+
+	return commonserver.Service{
+		Name: translationv1grpc.TranslationService_ServiceDesc.ServiceName,
+		Register: func(registrar grpc.ServiceRegistrar) {
+			translationv1grpc.RegisterTranslationServiceServer(registrar, controller)
+		},
+		RegisterGateway: gatewayv1.RegisterTranslationServiceHandler,
+		OpenAPIPath:     gatewayv1.OpenAPIPath,
+		OpenAPI:         gatewayv1.OpenAPI,
 	}
-
-	translationv1grpc.RegisterTranslationServiceServer(server.grpcServer, controllerv1.NewRoutingController())
-
-	reflection.Register(server.grpcServer)
-
-	return server, nil
-}
-
-func (s Server) close() {
-	s.grpcServer.GracefulStop()
 }
 
-func (s Server) start() error {
-	listen, err := net.Listen("tcp", s.cfg.ListenAddress)
-	if err != nil {
-		return fmt.Errorf("failed to listen on %s: %w", s.cfg.ListenAddress, err)
-	}
-
-	go func() {
-		slog.Info("Starting server", "address", s.cfg.ListenAddress)
-
-		if err := s.grpcServer.Serve(listen); err != nil {
-			slog.Error("Server stopped unexpectedly", "error", err)
-		}
-	}()
-
-	return nil
+// RegisterTranslationService registers the translation service on registrar, eg. an existing gRPC server.
+// The translators of the RPC methods can be replaced with the options.
+func RegisterTranslationService(registrar grpc.ServiceRegistrar, opts ...service.Option) {
+	translationv1grpc.RegisterTranslationServiceServer(registrar, controllerv1.NewRoutingController(opts...))
 }
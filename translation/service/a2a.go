@@ -2,7 +2,6 @@ package service
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
@@ -18,7 +17,7 @@ func buildA2ACard(record *objectsv3.Record) (*A2ACard, error) {
 	}
 
 	if a2aExt == nil {
-		return nil, errors.New("A2A extension not found in record")
+		return nil, &ExtensionNotFoundError{Extension: "A2A"}
 	}
 
 	jsonBytes, err := json.Marshal(a2aExt.Data.AsMap())
@@ -28,7 +27,10 @@ func buildA2ACard(record *objectsv3.Record) (*A2ACard, error) {
 
 	var card A2ACard
 	if err := json.Unmarshal(jsonBytes, &card); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal A2A data into A2ACard: %w", err)
+		return nil, &InvalidExtensionError{
+			Extension: "A2A",
+			Err:       fmt.Errorf("failed to unmarshal A2A data into A2ACard: %w", err),
+		}
 	}
 
 	return &card, nil
@@ -1,6 +1,9 @@
 package service
 
-type TranslationService struct{}
+// TranslationService translates records into other formats. It is safe for concurrent use.
+type TranslationService struct {
+	registry *Registry
+}
 
 type VSCodeCopilotMCPConfig struct {
 	Servers map[string]Server `json:"servers"`
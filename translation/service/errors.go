@@ -0,0 +1,50 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Reasons of the ErrorInfo details of the errors returned by the translation server, which
+// clients can branch on.
+const (
+	ReasonExtensionNotFound = "EXTENSION_NOT_FOUND"
+	ReasonInvalidExtension  = "INVALID_EXTENSION"
+	ReasonFormatNotFound    = "FORMAT_NOT_FOUND"
+)
+
+// ExtensionNotFoundError is returned when a record lacks the extension a translation is built from.
+type ExtensionNotFoundError struct {
+	Extension string
+}
+
+func (e *ExtensionNotFoundError) Error() string {
+	return fmt.Sprintf("%s extension not found in record", e.Extension)
+}
+
+// InvalidExtensionError is returned when the data of an extension cannot be translated.
+type InvalidExtensionError struct {
+	Extension string
+	Err       error
+}
+
+func (e *InvalidExtensionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *InvalidExtensionError) Unwrap() error {
+	return e.Err
+}
+
+// FormatNotFoundError is returned when no translator is registered for a format.
+type FormatNotFoundError struct {
+	Format           string
+	AvailableFormats []string
+}
+
+func (e *FormatNotFoundError) Error() string {
+	return fmt.Sprintf("unknown format %q, expected one of: %s", e.Format, strings.Join(e.AvailableFormats, ", "))
+}
@@ -18,17 +18,23 @@ func buildVSCodeCopilotMCPConfig(record *objectsv3.Record) (*VSCodeCopilotMCPCon
 	}
 
 	if mcpExt == nil {
-		return nil, errors.New("MCP extension not found in record")
+		return nil, &ExtensionNotFoundError{Extension: "MCP"}
 	}
 
 	serversVal, ok := mcpExt.Data.Fields["servers"]
 	if !ok {
-		return nil, errors.New("invalid or missing 'servers' in MCP extension data")
+		return nil, &InvalidExtensionError{
+			Extension: "MCP",
+			Err:       errors.New("invalid or missing 'servers' in MCP extension data"),
+		}
 	}
 
 	serversStruct := serversVal.GetStructValue()
 	if serversStruct == nil {
-		return nil, errors.New("'servers' is not a struct")
+		return nil, &InvalidExtensionError{
+			Extension: "MCP",
+			Err:       errors.New("'servers' is not a struct"),
+		}
 	}
 
 	servers := make(map[string]Server)
@@ -42,7 +48,10 @@ func buildVSCodeCopilotMCPConfig(record *objectsv3.Record) (*VSCodeCopilotMCPCon
 
 		command, ok := serverMap.Fields["command"]
 		if !ok {
-			return nil, fmt.Errorf("missing 'command' for server '%s'", serverName)
+			return nil, &InvalidExtensionError{
+				Extension: "MCP",
+				Err:       fmt.Errorf("missing 'command' for server '%s'", serverName),
+			}
 		}
 
 		args := []string{}
@@ -0,0 +1,49 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Reasons of failed translations.
+const (
+	reasonNone             = ""
+	reasonMissingExtension = "missing_extension"
+	reasonInvalidExtension = "invalid_extension"
+	reasonInternal         = "internal"
+)
+
+var translations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "oasf",
+	Subsystem: "translation",
+	Name:      "translations_total",
+	Help:      "Number of translations, by format and failure reason, which is empty for successful translations.",
+}, []string{"format", "reason"})
+
+// observeTranslation records the outcome of a translation to or from format.
+func observeTranslation(format string, err error) {
+	translations.WithLabelValues(format, failureReason(err)).Inc()
+}
+
+func failureReason(err error) string {
+	var (
+		notFoundErr *ExtensionNotFoundError
+		invalidErr  *InvalidExtensionError
+	)
+
+	switch {
+	case err == nil:
+		return reasonNone
+	case errors.As(err, &notFoundErr):
+		return reasonMissingExtension
+	case errors.As(err, &invalidErr):
+		return reasonInvalidExtension
+	default:
+		return reasonInternal
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserveTranslationFormats(t *testing.T) {
+	translator := NewTranslationService()
+	record := &objectsv3.Record{}
+
+	tests := []struct {
+		name      string
+		format    string
+		translate func(ctx context.Context) error
+	}{
+		{
+			name:   "translate vscode",
+			format: FormatVSCodeCopilot,
+			translate: func(ctx context.Context) error {
+				_, err := translator.Translate(ctx, FormatVSCodeCopilot, record)
+
+				return err
+			},
+		},
+		{
+			name:   "to vscode",
+			format: FormatVSCodeCopilot,
+			translate: func(ctx context.Context) error {
+				_, err := translator.ToVSCodeCopilot(ctx, record)
+
+				return err
+			},
+		},
+		{
+			name:   "translate a2a",
+			format: FormatA2A,
+			translate: func(ctx context.Context) error {
+				_, err := translator.Translate(ctx, FormatA2A, record)
+
+				return err
+			},
+		},
+		{
+			name:   "to a2a",
+			format: FormatA2A,
+			translate: func(ctx context.Context) error {
+				_, err := translator.ToA2A(ctx, record)
+
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := translations.WithLabelValues(tt.format, reasonMissingExtension)
+			before := testutil.ToFloat64(counter)
+
+			if err := tt.translate(t.Context()); err == nil {
+				t.Fatal("expected the translation of a record without extensions to fail")
+			}
+
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("got %v translations recorded for format %q, want 1", got, tt.format)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+)
+
+// Formats of the built-in translators.
+const (
+	FormatVSCodeCopilot = "vscode"
+	FormatA2A           = "a2a"
+)
+
+// Translator translates a record into a document of another format, which can be encoded as JSON.
+type Translator func(ctx context.Context, record *objectsv3.Record) (any, error)
+
+// Registry holds translators by format. Translators are registered before the registry is used,
+// and it is not safe to register translators concurrently with translations.
+type Registry struct {
+	translators map[string]Translator
+}
+
+// NewRegistry creates a registry without translators.
+func NewRegistry() *Registry {
+	return &Registry{translators: make(map[string]Translator)}
+}
+
+// DefaultRegistry creates a registry with the built-in translators.
+func DefaultRegistry() *Registry {
+	r := NewRegistry()
+
+	r.Register(FormatVSCodeCopilot, func(_ context.Context, record *objectsv3.Record) (any, error) {
+		return buildVSCodeCopilotMCPConfig(record)
+	})
+	r.Register(FormatA2A, func(_ context.Context, record *objectsv3.Record) (any, error) {
+		return buildA2ACard(record)
+	})
+
+	return r
+}
+
+// Register registers the translator of a format, replacing the translator already registered, if any.
+func (r *Registry) Register(format string, translator Translator) {
+	r.translators[format] = translator
+}
+
+// Translator returns the translator of a format.
+func (r *Registry) Translator(format string) (Translator, bool) {
+	translator, ok := r.translators[format]
+
+	return translator, ok
+}
+
+// Formats returns the formats of the registered translators, sorted by name.
+func (r *Registry) Formats() []string {
+	return slices.Sorted(maps.Keys(r.translators))
+}
+
+func (r *Registry) clone() *Registry {
+	return &Registry{translators: maps.Clone(r.translators)}
+}
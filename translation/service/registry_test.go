@@ -0,0 +1,78 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	translationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+)
+
+func TestTranslate(t *testing.T) {
+	name := func(_ context.Context, record *objectsv3.Record) (any, error) {
+		return record.GetName(), nil
+	}
+
+	translator := NewTranslationService(WithTranslator("name", name))
+
+	if got := translator.Formats(); !slices.Equal(got, []string{FormatA2A, "name", FormatVSCodeCopilot}) {
+		t.Errorf("got formats %v, want the built-in formats and name", got)
+	}
+
+	document, err := translator.Translate(t.Context(), "name", &objectsv3.Record{Name: "example"})
+	if err != nil {
+		t.Fatalf("failed to translate record: %v", err)
+	}
+
+	if document != "example" {
+		t.Errorf("got document %v, want the record name", document)
+	}
+
+	var formatErr *FormatNotFoundError
+	if _, err := translator.Translate(t.Context(), "unknown", &objectsv3.Record{}); !errors.As(err, &formatErr) {
+		t.Errorf("got error %v for an unknown format, want a FormatNotFoundError", err)
+	}
+
+	var extensionErr *ExtensionNotFoundError
+	if _, err := translator.Translate(t.Context(), FormatA2A, &objectsv3.Record{}); !errors.As(err, &extensionErr) {
+		t.Errorf("got error %v for a record without A2A extension, want an ExtensionNotFoundError", err)
+	}
+
+	// Services only use the translators of a registry at the time they are created.
+	registry := NewRegistry()
+	translator = NewTranslationService(WithRegistry(registry))
+	registry.Register("name", name)
+
+	if got := translator.Formats(); len(got) != 0 {
+		t.Errorf("got formats %v, want none", got)
+	}
+}
+
+func TestRecordToA2AUsesRegistry(t *testing.T) {
+	card := func(_ context.Context, record *objectsv3.Record) (any, error) {
+		return map[string]string{"name": record.GetName()}, nil
+	}
+
+	translator := NewTranslationService(WithTranslator(FormatA2A, card))
+
+	data, err := translator.RecordToA2A(t.Context(), &translationv1.RecordToA2ARequest{Record: &objectsv3.Record{Name: "example"}})
+	if err != nil {
+		t.Fatalf("failed to translate record: %v", err)
+	}
+
+	if got := data.GetFields()["a2aCard"].GetStructValue().GetFields()["name"].GetStringValue(); got != "example" {
+		t.Errorf("got card %v, want the card of the registered translator", data.GetFields()["a2aCard"])
+	}
+
+	translator = NewTranslationService(WithRegistry(NewRegistry()))
+
+	var formatErr *FormatNotFoundError
+	if _, err := translator.RecordToVSCodeCopilot(t.Context(), &translationv1.RecordToVSCodeCopilotRequest{Record: &objectsv3.Record{}}); !errors.As(err, &formatErr) {
+		t.Errorf("got error %v without a VSCode translator, want a FormatNotFoundError", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/agntcy/oasf-sdk/translation/service"
+
+// tracer returns the tracer of the global tracer provider. It is looked up on each use, as
+// tracers obtained earlier keep using the provider that was global at the time.
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// endSpan ends a span, recording err if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// traceStep runs a step of a translation in its own span. Steps are not started once ctx is done.
+func traceStep[T any](ctx context.Context, name string, step func() (T, error)) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	_, span := tracer().Start(ctx, name)
+
+	result, err := step()
+	endSpan(span, err)
+
+	return result, err
+}
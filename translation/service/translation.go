@@ -4,63 +4,190 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	translationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/translation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 )
 
-func NewTranslationService() *TranslationService {
-	return &TranslationService{}
+var errNilRecord = errors.New("record cannot be nil")
+
+// Option configures a TranslationService.
+type Option func(*TranslationService)
+
+// WithRegistry sets the translators used by Translate, RecordToVSCodeCopilot and RecordToA2A, which are
+// the built-in translators by default.
+// Translators registered to registry afterwards are not used.
+func WithRegistry(registry *Registry) Option {
+	return func(t *TranslationService) {
+		t.registry = registry.clone()
+	}
+}
+
+// WithTranslator registers the translator of a format, replacing the translator of the format, if any.
+func WithTranslator(format string, translator Translator) Option {
+	return func(t *TranslationService) {
+		t.registry.Register(format, translator)
+	}
+}
+
+// NewTranslationService creates a translation service.
+func NewTranslationService(opts ...Option) *TranslationService {
+	service := &TranslationService{
+		registry: DefaultRegistry(),
+	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	return service
+}
+
+// Formats returns the formats records can be translated into with Translate, sorted by name.
+func (t TranslationService) Formats() []string {
+	return t.registry.Formats()
+}
+
+// Translate translates a record into format with the registered translator of the format.
+func (t TranslationService) Translate(ctx context.Context, format string, record *objectsv3.Record) (_ any, err error) {
+	translator, ok := t.registry.Translator(format)
+	if !ok {
+		return nil, &FormatNotFoundError{Format: format, AvailableFormats: t.registry.Formats()}
+	}
+
+	ctx, span := tracer().Start(ctx, "translation.Translate", trace.WithAttributes(attribute.String("oasf.format", format)))
+	defer func() { endSpan(span, err) }()
+
+	if record == nil {
+		return nil, errNilRecord
+	}
+
+	document, err := traceStep(ctx, "translation.translate", func() (any, error) {
+		return translator(ctx, record)
+	})
+	observeTranslation(format, err)
+
+	return document, err
+}
+
+// ToVSCodeCopilot translates a record into the MCP configuration of VS Code Copilot.
+func (t TranslationService) ToVSCodeCopilot(ctx context.Context, record *objectsv3.Record) (_ *VSCodeCopilotMCPConfig, err error) {
+	ctx, span := tracer().Start(ctx, "translation.ToVSCodeCopilot")
+	defer func() { endSpan(span, err) }()
+
+	if record == nil {
+		return nil, errNilRecord
+	}
+
+	config, err := traceStep(ctx, "translation.buildVSCodeCopilotMCPConfig", func() (*VSCodeCopilotMCPConfig, error) {
+		return buildVSCodeCopilotMCPConfig(record)
+	})
+	observeTranslation(FormatVSCodeCopilot, err)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build VSCode MCP config: %w", err)
+	}
+
+	return config, nil
+}
+
+// ToA2A translates a record into an A2A card.
+func (t TranslationService) ToA2A(ctx context.Context, record *objectsv3.Record) (_ *A2ACard, err error) {
+	ctx, span := tracer().Start(ctx, "translation.ToA2A")
+	defer func() { endSpan(span, err) }()
+
+	if record == nil {
+		return nil, errNilRecord
+	}
+
+	card, err := traceStep(ctx, "translation.buildA2ACard", func() (*A2ACard, error) {
+		return buildA2ACard(record)
+	})
+	observeTranslation(FormatA2A, err)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build A2A card: %w", err)
+	}
+
+	return card, nil
 }
 
-func (t TranslationService) RecordToVSCodeCopilot(req *translationv1.RecordToVSCodeCopilotRequest) (*structpb.Struct, error) {
-	vsCodeCopilotMCPConfig, err := buildVSCodeCopilotMCPConfig(req.Record)
+// RecordToVSCodeCopilot translates the record of a request with the translator registered for
+// FormatVSCodeCopilot. It is the counterpart of ToVSCodeCopilot for the requests of the gRPC service.
+func (t TranslationService) RecordToVSCodeCopilot(ctx context.Context, req *translationv1.RecordToVSCodeCopilotRequest) (_ *structpb.Struct, err error) {
+	ctx, span := tracer().Start(ctx, "translation.RecordToVSCodeCopilot")
+	defer func() { endSpan(span, err) }()
+
+	vsCodeCopilotMCPConfig, err := t.Translate(ctx, FormatVSCodeCopilot, req.GetRecord())
 	if err != nil {
 		return nil, fmt.Errorf("failed to build VSCode MCP config: %w", err)
 	}
 
-	return toStruct(map[string]any{
-		"mcpConfig": *vsCodeCopilotMCPConfig,
+	return toStruct(ctx, map[string]any{
+		"mcpConfig": vsCodeCopilotMCPConfig,
 	})
 }
 
-func (t TranslationService) GHCopilotToRecord() (*structpb.Struct, error) {
-	GHCopilotRecord, err := buildGHCopilotRecord()
+func (t TranslationService) GHCopilotToRecord(ctx context.Context) (_ *structpb.Struct, err error) {
+	ctx, span := tracer().Start(ctx, "translation.GHCopilotToRecord")
+	defer func() { endSpan(span, err) }()
+
+	GHCopilotRecord, err := traceStep(ctx, "translation.buildGHCopilotRecord", buildGHCopilotRecord)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build MCP - Record: %w", err)
 	}
 
-	return toStruct(map[string]any{
+	return toStruct(ctx, map[string]any{
 		"record": GHCopilotRecord,
 	})
 
 }
 
-func (t TranslationService) RecordToA2A(req *translationv1.RecordToA2ARequest) (*structpb.Struct, error) {
-	a2aCard, err := buildA2ACard(req.Record)
+// RecordToA2A translates the record of a request with the translator registered for FormatA2A.
+// It is the counterpart of ToA2A for the requests of the gRPC service.
+func (t TranslationService) RecordToA2A(ctx context.Context, req *translationv1.RecordToA2ARequest) (_ *structpb.Struct, err error) {
+	ctx, span := tracer().Start(ctx, "translation.RecordToA2A")
+	defer func() { endSpan(span, err) }()
+
+	a2aCard, err := t.Translate(ctx, FormatA2A, req.GetRecord())
 	if err != nil {
 		return nil, fmt.Errorf("failed to build A2A card: %w", err)
 	}
 
-	return toStruct(map[string]any{
-		"a2aCard": *a2aCard,
+	return toStruct(ctx, map[string]any{
+		"a2aCard": a2aCard,
 	})
 }
 
-func (t TranslationService) A2AToRecord() (*structpb.Struct, error) {
-	A2ARecord, err := buildA2ARecord()
+func (t TranslationService) A2AToRecord(ctx context.Context) (_ *structpb.Struct, err error) {
+	ctx, span := tracer().Start(ctx, "translation.A2AToRecord")
+	defer func() { endSpan(span, err) }()
+
+	A2ARecord, err := traceStep(ctx, "translation.buildA2ARecord", buildA2ARecord)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build A2A - Record: %w", err)
 	}
 
-	return toStruct(map[string]any{
+	return toStruct(ctx, map[string]any{
 		"record": A2ARecord,
 	})
 }
 
-func toStruct(v any) (*structpb.Struct, error) {
+// toStruct converts a translation into a protobuf struct.
+func toStruct(ctx context.Context, v any) (*structpb.Struct, error) {
+	return traceStep(ctx, "translation.toStruct", func() (*structpb.Struct, error) {
+		return newStruct(v)
+	})
+}
+
+func newStruct(v any) (*structpb.Struct, error) {
 	jsonBytes, err := json.Marshal(v)
 	if err != nil {
 		return nil, err
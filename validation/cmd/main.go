@@ -6,25 +6,39 @@ package main
 import (
 	"fmt"
 
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
 	"github.com/agntcy/oasf-sdk/validation/config"
 	"github.com/agntcy/oasf-sdk/validation/server"
 	"github.com/spf13/cobra"
 )
 
+var healthProbe bool
+
 var rootCmd = &cobra.Command{
 	Use:   "server",
 	Short: "Validation Server",
 	Long:  "A server for handling validation requests.",
+	// Server errors are not usage errors.
+	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		cfg, err := config.LoadConfig()
+		cfg, err := config.LoadConfig(cmd.Flags())
 		if err != nil {
 			return fmt.Errorf("failed to load config: %w", err)
 		}
 
+		if healthProbe {
+			return commonserver.Probe(cmd.Context(), cfg.Config, "")
+		}
+
 		return server.Run(cmd.Context(), cfg)
 	},
 }
 
+func init() {
+	config.AddFlags(rootCmd.Flags())
+	rootCmd.Flags().BoolVar(&healthProbe, "health-probe", false, "check the health of the running server and exit")
+}
+
 func main() {
 	cobra.CheckErr(rootCmd.Execute())
 }
@@ -4,45 +4,150 @@
 package config
 
 import (
+	"errors"
 	"fmt"
-	"strings"
+	"slices"
+	"time"
 
-	"github.com/mitchellh/mapstructure"
-	"github.com/spf13/viper"
+	commonconfig "github.com/agntcy/oasf-sdk/common/config"
+	"github.com/agntcy/oasf-sdk/common/server"
+	"github.com/agntcy/oasf-sdk/validation/service"
+	"github.com/spf13/pflag"
 )
 
 const (
-	DefaultEnvPrefix     = "VALIDATION_SERVER"
-	DefaultListenAddress = "0.0.0.0:31235"
+	DefaultEnvPrefix            = "VALIDATION_SERVER"
+	DefaultListenAddress        = "0.0.0.0:31235"
+	DefaultHTTPListenAddress    = "0.0.0.0:31245"
+	DefaultMetricsListenAddress = "0.0.0.0:31255"
+	DefaultServiceName          = "oasf-sdk-validation"
+
+	DefaultSchemaVersionFallback = "none"
+
+	DefaultStreamWorkers     = 4
+	DefaultStreamMaxInFlight = 64
+
+	DefaultMaxSchemaSize  = service.DefaultMaxSchemaSize
+	DefaultSchemaCacheTTL = service.DefaultSchemaCacheTTL
 )
 
 type Config struct {
-	ListenAddress string `json:"listen_address,omitempty" mapstructure:"listen_address"`
-}
+	server.Config `mapstructure:",squash"`
 
-func LoadConfig() (*Config, error) {
-	v := viper.NewWithOptions(
-		viper.KeyDelimiter("."),
-		viper.EnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_")),
-	)
+	// SchemaVersionFallback decides whether records with a schema version that is not embedded
+	// are validated against the closest embedded version. One of: none, patch, minor.
+	SchemaVersionFallback string `json:"schema_version_fallback,omitempty" mapstructure:"schema_version_fallback"`
 
-	v.SetEnvPrefix(DefaultEnvPrefix)
-	v.AllowEmptyEnv(true)
-	v.AutomaticEnv()
+	// StreamWorkers is the number of records of a single stream validated in parallel.
+	StreamWorkers int `json:"stream_workers,omitempty" mapstructure:"stream_workers"`
 
-	_ = v.BindEnv("listen_address")
-	v.SetDefault("listen_address", DefaultListenAddress)
+	// StreamMaxInFlight is the maximum number of records of a single stream that have been received
+	// but not yet answered. Receiving stops until responses are sent once the limit is reached.
+	StreamMaxInFlight int `json:"stream_max_in_flight,omitempty" mapstructure:"stream_max_in_flight"`
 
-	decodeHooks := mapstructure.ComposeDecodeHookFunc(
-		mapstructure.TextUnmarshallerHookFunc(),
-		mapstructure.StringToTimeDurationHookFunc(),
-		mapstructure.StringToSliceHookFunc(","),
-	)
+	// MaxSchemaSize is the maximum size in bytes of the schema at a schema URL.
+	MaxSchemaSize int64 `json:"max_schema_size,omitempty" mapstructure:"max_schema_size"`
+
+	// SchemaCacheTTL is how long a schema fetched from a schema URL is reused, zero to disable the cache.
+	SchemaCacheTTL time.Duration `json:"schema_cache_ttl" mapstructure:"schema_cache_ttl"`
+}
 
+// LoadConfig loads the configuration from the flags added by AddFlags, if not nil,
+// the environment and the configuration file, and validates it.
+func LoadConfig(flags *pflag.FlagSet) (*Config, error) {
 	config := &Config{}
-	if err := v.Unmarshal(config, viper.DecodeHook(decodeHooks)); err != nil {
-		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	if err := commonconfig.Load(commonconfig.NewViper(DefaultEnvPrefix), Options(), flags, config); err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
 	return config, nil
 }
+
+// AddFlags adds the flags of the configuration options to flags.
+func AddFlags(flags *pflag.FlagSet) {
+	commonconfig.AddFlags(flags, Options())
+}
+
+// Options returns the options of the validation server.
+func Options() []commonconfig.Option {
+	return slices.Concat(
+		server.Options("", server.Config{
+			ListenAddress:        DefaultListenAddress,
+			HTTPListenAddress:    DefaultHTTPListenAddress,
+			MetricsListenAddress: DefaultMetricsListenAddress,
+			Tracing: server.TracingConfig{
+				SampleRatio: server.DefaultSampleRatio,
+				ServiceName: DefaultServiceName,
+			},
+		}),
+		ServiceOptions(""),
+	)
+}
+
+// ServiceOptions returns the options of the validation service nested under key,
+// so that they can be loaded as part of the configuration of another server.
+func ServiceOptions(key string) []commonconfig.Option {
+	return []commonconfig.Option{
+		{
+			Key:     commonconfig.Key(key, "schema_version_fallback"),
+			Default: DefaultSchemaVersionFallback,
+			Usage:   "embedded schema used for unknown schema versions: none, patch or minor",
+		},
+		{
+			Key:     commonconfig.Key(key, "stream_workers"),
+			Default: DefaultStreamWorkers,
+			Usage:   "number of records of a stream validated in parallel",
+		},
+		{
+			Key:     commonconfig.Key(key, "stream_max_in_flight"),
+			Default: DefaultStreamMaxInFlight,
+			Usage:   "maximum number of records of a stream received but not yet answered",
+		},
+		{
+			Key:     commonconfig.Key(key, "max_schema_size"),
+			Default: DefaultMaxSchemaSize,
+			Usage:   "maximum size in bytes of the schema at a schema URL",
+		},
+		{
+			Key:     commonconfig.Key(key, "schema_cache_ttl"),
+			Default: DefaultSchemaCacheTTL,
+			Usage:   "how long a schema fetched from a schema URL is reused, 0 to disable the cache",
+		},
+	}
+}
+
+// Validate checks the server and validation service options.
+func (c *Config) Validate() error {
+	return errors.Join(c.Config.Validate(), ValidateServiceConfig(c, ""))
+}
+
+// ValidateServiceConfig checks the options of the validation service nested under key.
+func ValidateServiceConfig(c *Config, key string) error {
+	var errs []error
+
+	if _, err := service.ParseVersionFallback(c.SchemaVersionFallback); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %w", commonconfig.Key(key, "schema_version_fallback"), err))
+	}
+
+	if c.StreamWorkers < 1 {
+		errs = append(errs, fmt.Errorf("%s: %d is not positive", commonconfig.Key(key, "stream_workers"), c.StreamWorkers))
+	}
+
+	if c.StreamMaxInFlight < 1 {
+		errs = append(errs, fmt.Errorf("%s: %d is not positive", commonconfig.Key(key, "stream_max_in_flight"), c.StreamMaxInFlight))
+	}
+
+	if c.MaxSchemaSize < 1 {
+		errs = append(errs, fmt.Errorf("%s: %d is not positive", commonconfig.Key(key, "max_schema_size"), c.MaxSchemaSize))
+	}
+
+	if c.SchemaCacheTTL < 0 {
+		errs = append(errs, fmt.Errorf("%s: %s is negative", commonconfig.Key(key, "schema_cache_ttl"), c.SchemaCacheTTL))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
+	"github.com/agntcy/oasf-sdk/validation/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Request fields that hold the schema version of the validated record.
+const (
+	recordSchemaVersionField   = "record.schema_version"
+	documentSchemaVersionField = "document"
+)
+
+// errorStatus converts a validation service error into a status error with the matching code and error details.
+// schemaVersionField is the request field at fault when the schema version of the record is not supported.
+func errorStatus(err error, schemaVersionField string) error {
+	var (
+		schemaVersionErr    *service.SchemaVersionError
+		invalidSchemaURLErr *service.InvalidSchemaURLError
+		schemaURLErr        *service.SchemaURLError
+		invalidSchemaErr    *service.InvalidSchemaError
+	)
+
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.As(err, &schemaVersionErr):
+		return commonserver.ErrorStatus(codes.NotFound, err, service.ReasonSchemaVersionNotFound,
+			map[string]string{
+				"schema_version":     schemaVersionErr.Version,
+				"available_versions": strings.Join(schemaVersionErr.AvailableVersions, ","),
+			},
+			commonserver.FieldViolation(schemaVersionField, "no embedded schema matches the schema version"),
+		)
+	case errors.As(err, &invalidSchemaURLErr):
+		return commonserver.ErrorStatus(codes.InvalidArgument, err, service.ReasonInvalidSchemaURL,
+			map[string]string{"schema_url": invalidSchemaURLErr.URL},
+			commonserver.FieldViolation("schema_url", invalidSchemaURLErr.Err.Error()),
+		)
+	case errors.As(err, &schemaURLErr):
+		metadata := map[string]string{"schema_url": schemaURLErr.URL}
+		if schemaURLErr.StatusCode != 0 {
+			metadata["http_status"] = strconv.Itoa(schemaURLErr.StatusCode)
+		}
+
+		return commonserver.ErrorStatus(schemaURLCode(schemaURLErr.StatusCode), err, service.ReasonSchemaURLUnavailable, metadata)
+	case errors.As(err, &invalidSchemaErr):
+		return commonserver.ErrorStatus(codes.FailedPrecondition, err, service.ReasonInvalidSchema,
+			map[string]string{"schema_url": invalidSchemaErr.URL},
+		)
+	default:
+		return commonserver.ErrorStatus(codes.Internal, err, commonserver.ErrorReasonInternal, nil)
+	}
+}
+
+// schemaURLCode returns the code of a failed schema URL fetch: Unavailable when retrying may succeed,
+// ie. when no response was received or the response is a server error or a throttling, and
+// FailedPrecondition otherwise, eg. when the schema does not exist.
+func schemaURLCode(httpStatus int) codes.Code {
+	switch {
+	case httpStatus == 0,
+		httpStatus >= http.StatusInternalServerError,
+		httpStatus == http.StatusRequestTimeout,
+		httpStatus == http.StatusTooManyRequests:
+		return codes.Unavailable
+	default:
+		return codes.FailedPrecondition
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Outcomes of a stream item.
+const (
+	streamItemValid   = "valid"
+	streamItemInvalid = "invalid"
+	streamItemError   = "error"
+)
+
+var streamItems = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "oasf",
+	Subsystem: "validation",
+	Name:      "stream_items_total",
+	Help:      "Number of ValidateRecordStream items answered, by outcome.",
+}, []string{"outcome"})
+
+// observeStreamItem records the outcome of a stream item.
+func observeStreamItem(response *validationv1.ValidateRecordStreamResponse) {
+	switch {
+	case response.Error != nil:
+		streamItems.WithLabelValues(streamItemError).Inc()
+	case response.IsValid:
+		streamItems.WithLabelValues(streamItemValid).Inc()
+	default:
+		streamItems.WithLabelValues(streamItemInvalid).Inc()
+	}
+}
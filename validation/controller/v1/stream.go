@@ -0,0 +1,248 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamItem is a record of a stream along with its position in the input.
+type streamItem struct {
+	seq      int
+	request  *validationv1.ValidateRecordStreamRequest
+	response *validationv1.ValidateRecordStreamResponse
+}
+
+// ValidateRecordStream validates the records of a stream on a pool of workers.
+//
+// At most streamMaxInFlight records are received but not yet answered at any time,
+// which applies backpressure to the client through gRPC flow control.
+// Responses are sent in input order, unless the first item opts into unordered responses.
+func (v validationCtrl) ValidateRecordStream(stream validationv1grpc.ValidationService_ValidateRecordStreamServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to receive record: %w", err)
+	}
+
+	unordered := first.Unordered
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// Slots are taken before receiving an item and released once its response is sent.
+	slots := make(chan struct{}, v.streamMaxInFlight)
+	items := make(chan *streamItem)
+	results := make(chan *streamItem)
+
+	recvErrs := make(chan error, 1)
+
+	go func() {
+		defer close(items)
+
+		req := first
+		for seq := 0; ; seq++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			if req == nil {
+				var err error
+
+				req, err = stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					recvErrs <- fmt.Errorf("failed to receive record: %w", err)
+					cancel()
+
+					return
+				}
+			}
+
+			select {
+			case items <- &streamItem{seq: seq, request: req}:
+			case <-ctx.Done():
+				return
+			}
+
+			req = nil
+		}
+	}()
+
+	var workers sync.WaitGroup
+	for range v.streamWorkers {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for {
+				var item *streamItem
+
+				select {
+				case next, ok := <-items:
+					if !ok {
+						return
+					}
+
+					item = next
+				case <-ctx.Done():
+					return
+				}
+
+				item.response = v.validateStreamItem(ctx, item.request, unordered)
+				observeStreamItem(item.response)
+
+				select {
+				case results <- item:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	sendErr := v.sendStreamResults(stream, results, slots, unordered)
+	if sendErr != nil {
+		cancel()
+
+		// Wait for the workers so that no validation outlives the stream.
+		for range results {
+			continue
+		}
+	}
+
+	select {
+	case err := <-recvErrs:
+		return err
+	default:
+	}
+
+	if sendErr != nil {
+		return sendErr
+	}
+
+	// Items that were not answered before the deadline or the cancellation of the stream are dropped.
+	if err := stream.Context().Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return nil
+}
+
+// sendStreamResults sends validation results on the stream, in input order unless unordered is set.
+func (v validationCtrl) sendStreamResults(stream validationv1grpc.ValidationService_ValidateRecordStreamServer, results <-chan *streamItem, slots <-chan struct{}, unordered bool) error {
+	pending := make(map[int]*streamItem)
+	next := 0
+
+	send := func(item *streamItem) error {
+		if err := stream.Send(item.response); err != nil {
+			return fmt.Errorf("failed to send response: %w", err)
+		}
+
+		<-slots
+
+		return nil
+	}
+
+	for item := range results {
+		if unordered {
+			if err := send(item); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		pending[item.seq] = item
+		for {
+			ready, ok := pending[next]
+			if !ok {
+				break
+			}
+
+			if err := send(ready); err != nil {
+				return err
+			}
+
+			delete(pending, next)
+			next++
+		}
+	}
+
+	return nil
+}
+
+// validateStreamItem validates a single stream item. Failures are reported on the
+// response instead of ending the stream.
+func (v validationCtrl) validateStreamItem(ctx context.Context, req *validationv1.ValidateRecordStreamRequest, unordered bool) *validationv1.ValidateRecordStreamResponse {
+	response := &validationv1.ValidateRecordStreamResponse{
+		CorrelationId: req.CorrelationId,
+	}
+
+	if unordered && req.CorrelationId == "" {
+		response.Error = &validationv1.ValidateRecordStreamError{
+			Code:    int32(codes.InvalidArgument),
+			Message: "correlation_id is required for unordered streams",
+		}
+
+		return response
+	}
+
+	if err := authorizeSchemaURL(ctx, req.SchemaUrl); err != nil {
+		response.Error = &validationv1.ValidateRecordStreamError{
+			Code:    int32(status.Code(err)),
+			Message: status.Convert(err).Message(),
+		}
+
+		return response
+	}
+
+	validateReq := &validationv1.ValidateRecordRequest{
+		Record:              req.Record,
+		SchemaUrl:           req.SchemaUrl,
+		DetectSchemaVersion: req.DetectSchemaVersion,
+	}
+
+	result, err := v.validationService.ValidateRecord(ctx, validateReq)
+	if err != nil {
+		slog.Warn("Failed to validate stream item", "correlation_id", req.CorrelationId, "error", err)
+
+		st := status.Convert(errorStatus(fmt.Errorf("failed to validate record: %w", err), recordSchemaVersionField))
+		response.Error = &validationv1.ValidateRecordStreamError{
+			Code:    int32(st.Code()),
+			Message: st.Message(),
+		}
+
+		return response
+	}
+
+	response.IsValid = result.IsValid
+	response.Errors = result.Errors
+	response.SchemaVersion = result.SchemaVersion
+	response.Warnings = result.Warnings
+
+	return response
+}
@@ -0,0 +1,162 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"github.com/agntcy/oasf-sdk/validation/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+//go:embed testdata/record_v0.6.0.json
+var recordV060 []byte
+
+func newStreamClient(tb testing.TB, cfg *config.Config) validationv1grpc.ValidationServiceClient {
+	tb.Helper()
+
+	controller, err := NewValidationController(cfg)
+	if err != nil {
+		tb.Fatalf("failed to create validation controller: %v", err)
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	validationv1grpc.RegisterValidationServiceServer(server, controller)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	tb.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		tb.Fatalf("failed to create client: %v", err)
+	}
+	tb.Cleanup(func() { _ = conn.Close() })
+
+	return validationv1grpc.NewValidationServiceClient(conn)
+}
+
+// BenchmarkValidateRecordStream measures stream throughput on the embedded v0.6.0 schema.
+func BenchmarkValidateRecordStream(b *testing.B) {
+	var record objectsv3.Record
+	if err := protojson.Unmarshal(recordV060, &record); err != nil {
+		b.Fatalf("failed to unmarshal record: %v", err)
+	}
+
+	for _, workers := range []int{1, 2, 4, 8} {
+		for _, unordered := range []bool{false, true} {
+			b.Run(fmt.Sprintf("workers=%d/unordered=%t", workers, unordered), func(b *testing.B) {
+				client := newStreamClient(b, &config.Config{
+					StreamWorkers:     workers,
+					StreamMaxInFlight: config.DefaultStreamMaxInFlight,
+				})
+
+				stream, err := client.ValidateRecordStream(b.Context())
+				if err != nil {
+					b.Fatalf("failed to open stream: %v", err)
+				}
+
+				b.ResetTimer()
+
+				go func() {
+					for i := range b.N {
+						req := &validationv1.ValidateRecordStreamRequest{
+							Record:        &record,
+							CorrelationId: fmt.Sprint(i),
+							Unordered:     unordered,
+						}
+						if err := stream.Send(req); err != nil {
+							return
+						}
+					}
+
+					_ = stream.CloseSend()
+				}()
+
+				for range b.N {
+					resp, err := stream.Recv()
+					if err != nil {
+						b.Fatalf("failed to receive response: %v", err)
+					}
+
+					if !resp.IsValid {
+						b.Fatalf("expected valid record, got errors: %v", resp.Errors)
+					}
+				}
+
+				b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "records/s")
+			})
+		}
+	}
+}
+
+func TestValidateRecordStreamHonorsDeadline(t *testing.T) {
+	var record objectsv3.Record
+	if err := protojson.Unmarshal(recordV060, &record); err != nil {
+		t.Fatalf("failed to unmarshal record: %v", err)
+	}
+
+	// The schema server never answers, so that items stay in flight until the deadline.
+	schemaServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(schemaServer.Close)
+
+	client := newStreamClient(t, &config.Config{
+		StreamWorkers:     2,
+		StreamMaxInFlight: config.DefaultStreamMaxInFlight,
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancel()
+
+	stream, err := client.ValidateRecordStream(ctx)
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+
+	for i := range 4 {
+		req := &validationv1.ValidateRecordStreamRequest{
+			Record:        &record,
+			SchemaUrl:     schemaServer.URL,
+			CorrelationId: fmt.Sprint(i),
+		}
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("failed to send record: %v", err)
+		}
+	}
+
+	start := time.Now()
+
+	_, err = stream.Recv()
+	if code := status.Code(err); code != codes.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", codes.DeadlineExceeded, err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the stream to end at its deadline, took %v", elapsed)
+	}
+}
@@ -6,21 +6,42 @@ package v1
 import (
 	"context"
 	"fmt"
-	"io"
-	"log/slog"
 
 	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
 	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	"github.com/agntcy/oasf-sdk/common/auth"
+	"github.com/agntcy/oasf-sdk/validation/config"
 	"github.com/agntcy/oasf-sdk/validation/service"
 )
 
+// schemaURLPermission restricts validating records against a schema URL, which makes the server
+// fetch arbitrary URLs.
+const schemaURLPermission = "validation.schema_url"
+
 type validationCtrl struct {
 	validationv1grpc.UnimplementedValidationServiceServer
 	validationService *service.ValidationService
+	streamWorkers     int
+	streamMaxInFlight int
 }
 
-func NewValidationController() (validationv1grpc.ValidationServiceServer, error) {
-	validationService, err := service.NewValidationService()
+// NewValidationController creates the gRPC service of the validation service configured by cfg.
+// The options are applied after the configuration, eg. to set custom schema sources or rules.
+func NewValidationController(cfg *config.Config, opts ...service.Option) (validationv1grpc.ValidationServiceServer, error) {
+	versionFallback, err := service.ParseVersionFallback(cfg.SchemaVersionFallback)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema version fallback: %w", err)
+	}
+
+	cfgOpts := []service.Option{
+		service.WithVersionFallback(versionFallback),
+		service.WithSchemaCacheTTL(cfg.SchemaCacheTTL),
+	}
+	if cfg.MaxSchemaSize > 0 {
+		cfgOpts = append(cfgOpts, service.WithMaxSchemaSize(cfg.MaxSchemaSize))
+	}
+
+	validationService, err := service.NewValidationService(append(cfgOpts, opts...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create validation service: %w", err)
 	}
@@ -28,52 +49,54 @@ func NewValidationController() (validationv1grpc.ValidationServiceServer, error)
 	return &validationCtrl{
 		UnimplementedValidationServiceServer: validationv1grpc.UnimplementedValidationServiceServer{},
 		validationService:                    validationService,
+		streamWorkers:                        max(cfg.StreamWorkers, 1),
+		streamMaxInFlight:                    max(cfg.StreamMaxInFlight, 1),
 	}, nil
 }
 
-func (v validationCtrl) ValidateRecord(_ context.Context, req *validationv1.ValidateRecordRequest) (*validationv1.ValidateRecordResponse, error) {
-	slog.Info("Received ValidateRecord request", "request", req)
+func (v validationCtrl) ValidateRecord(ctx context.Context, req *validationv1.ValidateRecordRequest) (*validationv1.ValidateRecordResponse, error) {
+	if err := authorizeSchemaURL(ctx, req.SchemaUrl); err != nil {
+		return nil, err
+	}
 
-	isValid, errors, err := v.validationService.ValidateRecord(req)
+	result, err := v.validationService.ValidateRecord(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate record: %w", err)
+		return nil, errorStatus(fmt.Errorf("failed to validate record: %w", err), recordSchemaVersionField)
 	}
 
 	return &validationv1.ValidateRecordResponse{
-		IsValid: isValid,
-		Errors:  errors,
+		IsValid:       result.IsValid,
+		Errors:        result.Errors,
+		SchemaVersion: result.SchemaVersion,
+		Warnings:      result.Warnings,
+		Sarif:         result.SARIF,
 	}, nil
 }
 
-func (v validationCtrl) ValidateRecordStream(stream validationv1grpc.ValidationService_ValidateRecordStreamServer) error {
-	slog.Info("Received ValidateRecordStream request")
-
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			return nil
-		}
-		if err != nil {
-			return fmt.Errorf("failed to receive record: %w", err)
-		}
-
-		validateReq := &validationv1.ValidateRecordRequest{
-			Record:    req.Record,
-			SchemaUrl: req.SchemaUrl,
-		}
-
-		isValid, errors, validationErr := v.validationService.ValidateRecord(validateReq)
-		if validationErr != nil {
-			return fmt.Errorf("failed to validate record: %w", validationErr)
-		}
-
-		response := &validationv1.ValidateRecordStreamResponse{
-			IsValid: isValid,
-			Errors:  errors,
-		}
-
-		if err := stream.Send(response); err != nil {
-			return fmt.Errorf("failed to send response: %w", err)
-		}
+func (v validationCtrl) ValidateRecordDocument(ctx context.Context, req *validationv1.ValidateRecordDocumentRequest) (*validationv1.ValidateRecordDocumentResponse, error) {
+	if err := authorizeSchemaURL(ctx, req.SchemaUrl); err != nil {
+		return nil, err
 	}
+
+	result, err := v.validationService.ValidateRecordDocument(ctx, req)
+	if err != nil {
+		return nil, errorStatus(fmt.Errorf("failed to validate record document: %w", err), documentSchemaVersionField)
+	}
+
+	return &validationv1.ValidateRecordDocumentResponse{
+		IsValid:       result.IsValid,
+		Issues:        result.Issues,
+		SchemaVersion: result.SchemaVersion,
+		Warnings:      result.Warnings,
+		Sarif:         result.SARIF,
+	}, nil
+}
+
+// authorizeSchemaURL checks that the principal of a request may validate records against schemaURL, if set.
+func authorizeSchemaURL(ctx context.Context, schemaURL string) error {
+	if schemaURL == "" {
+		return nil
+	}
+
+	return auth.Authorize(ctx, schemaURLPermission)
 }
@@ -0,0 +1,35 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteJSONL writes one JSON object per line for every result, followed by
+// a last line holding the summary of the batch under the "summary" key.
+func WriteJSONL(w io.Writer, results []*Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	for _, result := range results {
+		if err := encoder.Encode(result); err != nil {
+			return fmt.Errorf("failed to write JSONL report: %w", err)
+		}
+	}
+
+	summary := struct {
+		Summary *Summary `json:"summary"`
+	}{
+		Summary: Summarize(results),
+	}
+
+	if err := encoder.Encode(summary); err != nil {
+		return fmt.Errorf("failed to write JSONL report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSONL(&buf, testResults()); err != nil {
+		t.Fatalf("failed to write JSONL report: %v", err)
+	}
+
+	checkGolden(t, "report.jsonl", buf.Bytes())
+}
@@ -0,0 +1,182 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	junitSuiteName = "oasf-validation"
+	junitClassName = "oasf.validation"
+)
+
+// JUnit XML types, in the format read by most CI test reporters.
+type (
+	junitTestSuites struct {
+		XMLName  xml.Name         `xml:"testsuites"`
+		Name     string           `xml:"name,attr"`
+		Tests    int              `xml:"tests,attr"`
+		Failures int              `xml:"failures,attr"`
+		Errors   int              `xml:"errors,attr"`
+		Suites   []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name       string          `xml:"name,attr"`
+		Tests      int             `xml:"tests,attr"`
+		Failures   int             `xml:"failures,attr"`
+		Errors     int             `xml:"errors,attr"`
+		Properties []junitProperty `xml:"properties>property"`
+		TestCases  []junitTestCase `xml:"testcase"`
+	}
+
+	junitProperty struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Failure   *junitProblem `xml:"failure,omitempty"`
+		Error     *junitProblem `xml:"error,omitempty"`
+		SystemOut string        `xml:"system-out,omitempty"`
+	}
+
+	junitProblem struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Text    string `xml:",cdata"`
+	}
+)
+
+// WriteJUnit writes the results of a batch of records as a JUnit XML report with
+// one test case per record. Invalid records are failures listing their issues, and
+// records that could not be validated are errors. The summary counts per schema
+// version and per keyword are written as properties of the test suite.
+func WriteJUnit(w io.Writer, results []*Result) error {
+	summary := Summarize(results)
+
+	suite := junitTestSuite{
+		Name:       junitSuiteName,
+		Tests:      summary.Total,
+		Failures:   summary.Invalid,
+		Errors:     summary.Failed,
+		Properties: junitProperties(summary),
+		TestCases:  make([]junitTestCase, 0, len(results)),
+	}
+
+	for i, result := range results {
+		testCase := junitTestCase{
+			Name:      result.File,
+			ClassName: junitClassName,
+			SystemOut: strings.Join(result.Warnings, "\n"),
+		}
+		if testCase.Name == "" {
+			testCase.Name = fmt.Sprintf("record %d", i+1)
+		}
+
+		switch {
+		case result.Error != "":
+			testCase.Error = &junitProblem{
+				Message: result.Error,
+				Type:    "error",
+			}
+		case !result.Valid:
+			lines := make([]string, 0, len(result.Issues))
+			for _, issue := range result.Issues {
+				line := issue.String()
+
+				switch {
+				case issue.Line > 0 && issue.Column > 0:
+					line = fmt.Sprintf("%d:%d: %s", issue.Line, issue.Column, line)
+				case issue.Line > 0:
+					line = fmt.Sprintf("%d: %s", issue.Line, line)
+				}
+
+				lines = append(lines, line)
+			}
+
+			testCase.Failure = &junitProblem{
+				Message: issueCount(len(result.Issues)),
+				Type:    "schema",
+				Text:    strings.Join(lines, "\n"),
+			}
+		}
+
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	report := junitTestSuites{
+		Name:     junitSuiteName,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Suites:   []junitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+
+	return nil
+}
+
+// issueCount describes the number of issues of a record, which include schema errors and rule violations.
+func issueCount(count int) string {
+	if count == 1 {
+		return "1 issue"
+	}
+
+	return fmt.Sprintf("%d issues", count)
+}
+
+// junitProperties lists the summary counts, sorted by name.
+func junitProperties(summary *Summary) []junitProperty {
+	properties := []junitProperty{
+		{Name: "valid", Value: fmt.Sprint(summary.Valid)},
+		{Name: "invalid", Value: fmt.Sprint(summary.Invalid)},
+		{Name: "failed", Value: fmt.Sprint(summary.Failed)},
+	}
+
+	properties = append(properties, countProperties("schema_version.", summary.SchemaVersions)...)
+	properties = append(properties, countProperties("keyword.", summary.Keywords)...)
+
+	return properties
+}
+
+func countProperties(prefix string, counts map[string]int) []junitProperty {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	properties := make([]junitProperty, 0, len(names))
+	for _, name := range names {
+		properties = append(properties, junitProperty{
+			Name:  prefix + name,
+			Value: fmt.Sprint(counts[name]),
+		})
+	}
+
+	return properties
+}
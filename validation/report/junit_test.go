@@ -0,0 +1,28 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+)
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, testResults()); err != nil {
+		t.Fatalf("failed to write JUnit report: %v", err)
+	}
+
+	checkGolden(t, "report.junit.xml", buf.Bytes())
+
+	var report junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("failed to decode JUnit report: %v", err)
+	}
+
+	if report.Tests != 5 || report.Failures != 3 || report.Errors != 1 {
+		t.Errorf("got %d tests, %d failures and %d errors, want 5, 3 and 1", report.Tests, report.Failures, report.Errors)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package report writes validation results in formats understood by CI tools.
+package report
+
+import (
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+)
+
+// StdinFile is the file of the results of records read from stdin.
+const StdinFile = "<stdin>"
+
+// Result is the validation result of a single record.
+type Result struct {
+	// File is the path or URI of the record, or StdinFile for records read from stdin, if known.
+	File string `json:"file,omitempty"`
+
+	Valid         bool     `json:"valid"`
+	SchemaVersion string   `json:"schema_version,omitempty"`
+	Warnings      []string `json:"warnings,omitempty"`
+	Issues        []*Issue `json:"issues,omitempty"`
+
+	// Error is set when the record could not be validated at all.
+	Error string `json:"error,omitempty"`
+}
+
+// Issue is a validation issue of a record.
+type Issue struct {
+	Message string `json:"message"`
+	Path    string `json:"path,omitempty"`
+	Keyword string `json:"keyword,omitempty"`
+	Line    uint32 `json:"line,omitempty"`
+	Column  uint32 `json:"column,omitempty"`
+}
+
+// NewIssues converts the issues of a validation response.
+func NewIssues(issues []*validationv1.ValidationIssue) []*Issue {
+	converted := make([]*Issue, 0, len(issues))
+	for _, issue := range issues {
+		converted = append(converted, &Issue{
+			Message: issue.GetMessage(),
+			Path:    issue.GetPath(),
+			Keyword: issue.GetKeyword(),
+			Line:    issue.GetLine(),
+			Column:  issue.GetColumn(),
+		})
+	}
+
+	return converted
+}
+
+// String formats the issue as its path, message and keyword.
+func (i *Issue) String() string {
+	message := i.Message
+	if i.Path != "" {
+		message = i.Path + ": " + message
+	}
+
+	if i.Keyword != "" {
+		message += " [" + i.Keyword + "]"
+	}
+
+	return message
+}
+
+// Summary counts the results of a batch of records.
+type Summary struct {
+	Total   int `json:"total"`
+	Valid   int `json:"valid"`
+	Invalid int `json:"invalid"`
+
+	// Failed counts the records that could not be validated.
+	Failed int `json:"failed"`
+
+	// SchemaVersions counts the validated records per schema version.
+	SchemaVersions map[string]int `json:"schema_versions"`
+
+	// Keywords counts the issues per failed schema keyword.
+	Keywords map[string]int `json:"keywords"`
+}
+
+// Summarize counts the results of a batch of records.
+func Summarize(results []*Result) *Summary {
+	summary := &Summary{
+		Total:          len(results),
+		SchemaVersions: make(map[string]int),
+		Keywords:       make(map[string]int),
+	}
+
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			summary.Failed++
+
+			continue
+		case result.Valid:
+			summary.Valid++
+		default:
+			summary.Invalid++
+		}
+
+		if result.SchemaVersion != "" {
+			summary.SchemaVersions[result.SchemaVersion]++
+		}
+
+		for _, issue := range result.Issues {
+			summary.Keywords[issueRule(issue)]++
+		}
+	}
+
+	return summary
+}
+
+// issueRule returns the rule an issue is reported under, which is its schema keyword.
+func issueRule(issue *Issue) string {
+	if issue.Keyword == "" {
+		return ruleSchema
+	}
+
+	return issue.Keyword
+}
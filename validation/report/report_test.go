@@ -0,0 +1,108 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// testResults covers every kind of result: valid with warnings, invalid, not validated,
+// read from stdin and without a file.
+func testResults() []*Result {
+	return []*Result{
+		{
+			File:          "records/valid.json",
+			Valid:         true,
+			SchemaVersion: "v0.6.0",
+			Warnings:      []string{"schema version is not set, validated against detected version v0.6.0"},
+		},
+		{
+			File:          "records/invalid 100%.yaml",
+			SchemaVersion: "v0.6.0",
+			Issues: []*Issue{
+				{Message: "Additional property unknown_field is not allowed", Path: "/unknown_field", Keyword: "additionalProperties", Line: 8, Column: 1},
+				{Message: "Invalid type. Expected: integer, given: string", Path: "/locators/0/size", Keyword: "type", Line: 18, Column: 11},
+				{Message: "records must have a description", Path: "/name", Keyword: "description-required", Line: 1},
+				{Message: "record cannot be nil"},
+			},
+		},
+		{
+			File:  "records/unreadable.json",
+			Error: "failed to read input: permission denied",
+		},
+		{
+			File:          StdinFile,
+			SchemaVersion: "v0.6.0",
+			Issues: []*Issue{
+				{Message: "name is required", Path: "", Keyword: "required", Line: 1, Column: 1},
+			},
+		},
+		{
+			SchemaVersion: "v0.5.0",
+			Issues: []*Issue{
+				{Message: "name is required", Path: "", Keyword: "required"},
+			},
+		},
+	}
+}
+
+// checkGolden compares got with the golden file of name, or updates it with -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("got output:\n%s\nwant the output of %s:\n%s", got, path, want)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	got := Summarize(testResults())
+
+	want := &Summary{
+		Total:          5,
+		Valid:          1,
+		Invalid:        3,
+		Failed:         1,
+		SchemaVersions: map[string]int{"v0.6.0": 3, "v0.5.0": 1},
+		Keywords: map[string]int{
+			"additionalProperties": 1,
+			"type":                 1,
+			"description-required": 1,
+			"schema":               1,
+			"required":             2,
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got summary %+v, want %+v", got, want)
+	}
+}
+
+func TestSummarizeEmpty(t *testing.T) {
+	got := Summarize(nil)
+
+	if got.Total != 0 || got.SchemaVersions == nil || got.Keywords == nil {
+		t.Errorf("got summary %+v, want empty counts", got)
+	}
+}
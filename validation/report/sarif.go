@@ -0,0 +1,280 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	toolName           = "oasf-sdk-validation"
+	toolInformationURI = "https://github.com/agntcy/oasf-sdk"
+
+	// ruleSchema is reported for issues without a schema keyword.
+	ruleSchema = "schema"
+
+	// ruleSchemaVersion is reported for warnings about the schema version a record was validated against.
+	ruleSchemaVersion = "schema-version"
+
+	// stdinURI is the artifact URI of the records read from stdin.
+	stdinURI = "stdin"
+)
+
+// ruleDescriptions describes the rules reported most often. Other schema keywords
+// get a generic description.
+var ruleDescriptions = map[string]string{
+	"syntax":               "The record document is not valid JSON or YAML.",
+	"required":             "A required property is missing.",
+	"additionalProperties": "A property is not defined by the schema.",
+	"type":                 "A value does not have the type expected by the schema.",
+	"enum":                 "A value is not one of the values allowed by the schema.",
+	"pattern":              "A string does not match the pattern expected by the schema.",
+	"format":               "A string does not have the format expected by the schema.",
+	ruleSchema:             "The record does not match the schema.",
+	ruleSchemaVersion:      "The record was not validated against the schema version it declares.",
+}
+
+// SARIF log types, limited to the properties written by this package.
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html.
+type (
+	sarifLog struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool        sarifTool         `json:"tool"`
+		Invocations []sarifInvocation `json:"invocations"`
+		Results     []sarifResult     `json:"results"`
+		ColumnKind  string            `json:"columnKind"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		InformationURI string      `json:"informationUri"`
+		Rules          []sarifRule `json:"rules"`
+	}
+
+	sarifRule struct {
+		ID               string       `json:"id"`
+		ShortDescription sarifMessage `json:"shortDescription"`
+	}
+
+	sarifInvocation struct {
+		ExecutionSuccessful        bool                `json:"executionSuccessful"`
+		ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+	}
+
+	sarifNotification struct {
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations,omitempty"`
+	}
+
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		RuleIndex int             `json:"ruleIndex"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations,omitempty"`
+	}
+
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+		LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *sarifRegion          `json:"region,omitempty"`
+	}
+
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	sarifRegion struct {
+		StartLine   uint32 `json:"startLine"`
+		StartColumn uint32 `json:"startColumn,omitempty"`
+	}
+
+	sarifLogicalLocation struct {
+		FullyQualifiedName string `json:"fullyQualifiedName"`
+		Kind               string `json:"kind"`
+	}
+)
+
+// SARIF converts the results of a batch of records into a single SARIF 2.1.0 log.
+// Every issue becomes a SARIF result whose rule is the failing schema keyword.
+// Records that could not be validated are reported as tool execution notifications.
+func SARIF(results []*Result) ([]byte, error) {
+	data, err := json.MarshalIndent(newSARIFLog(results), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode SARIF log: %w", err)
+	}
+
+	return data, nil
+}
+
+// WriteSARIF writes the results of a batch of records as a single SARIF 2.1.0 log.
+func WriteSARIF(w io.Writer, results []*Result) error {
+	data, err := SARIF(results)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w, string(data)); err != nil {
+		return fmt.Errorf("failed to write SARIF log: %w", err)
+	}
+
+	return nil
+}
+
+func newSARIFLog(results []*Result) *sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           toolName,
+				InformationURI: toolInformationURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+
+		// Issue columns count characters rather than UTF-16 code units.
+		ColumnKind: "unicodeCodePoints",
+	}
+
+	invocation := sarifInvocation{ExecutionSuccessful: true}
+	ruleIndexes := make(map[string]int)
+
+	addResult := func(ruleID, level, message string, locations []sarifLocation) {
+		index, ok := ruleIndexes[ruleID]
+		if !ok {
+			index = len(run.Tool.Driver.Rules)
+			ruleIndexes[ruleID] = index
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSARIFRule(ruleID))
+		}
+
+		result := sarifResult{
+			RuleID:    ruleID,
+			RuleIndex: index,
+			Level:     level,
+			Message:   sarifMessage{Text: message},
+			Locations: locations,
+		}
+
+		run.Results = append(run.Results, result)
+	}
+
+	for _, result := range results {
+		if result.Error != "" {
+			invocation.ExecutionSuccessful = false
+			invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, sarifNotification{
+				Level:     "error",
+				Message:   sarifMessage{Text: result.Error},
+				Locations: newSARIFLocations(result.File, nil),
+			})
+
+			continue
+		}
+
+		for _, warning := range result.Warnings {
+			addResult(ruleSchemaVersion, "warning", warning, newSARIFLocations(result.File, nil))
+		}
+
+		for _, issue := range result.Issues {
+			addResult(issueRule(issue), "error", issue.Message, newSARIFLocations(result.File, issue))
+		}
+	}
+
+	run.Invocations = []sarifInvocation{invocation}
+
+	return &sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{run},
+	}
+}
+
+func newSARIFRule(id string) sarifRule {
+	description, ok := ruleDescriptions[id]
+	if !ok {
+		description = fmt.Sprintf("A value does not satisfy the %q keyword of the schema.", id)
+	}
+
+	return sarifRule{
+		ID:               id,
+		ShortDescription: sarifMessage{Text: description},
+	}
+}
+
+// newSARIFLocations locates an issue in a file and in the record. No location is
+// returned when neither is known.
+func newSARIFLocations(file string, issue *Issue) []sarifLocation {
+	location := sarifLocation{}
+
+	if file != "" {
+		location.PhysicalLocation = &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: artifactURI(file)},
+		}
+
+		if issue != nil && issue.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegion{
+				StartLine:   issue.Line,
+				StartColumn: issue.Column,
+			}
+		}
+	}
+
+	if issue != nil && issue.Path != "" {
+		location.LogicalLocations = []sarifLogicalLocation{{
+			FullyQualifiedName: issue.Path,
+			Kind:               "member",
+		}}
+	}
+
+	if location.PhysicalLocation == nil && location.LogicalLocations == nil {
+		return nil
+	}
+
+	return []sarifLocation{location}
+}
+
+// artifactURI converts the file of a result into a URI reference. Paths are escaped, and absolute
+// paths are converted into file URIs. Files that are already URIs are kept as-is.
+func artifactURI(file string) string {
+	if file == StdinFile {
+		return stdinURI
+	}
+
+	// Paths may contain colons, so only URIs with an authority are kept.
+	if u, err := url.Parse(file); err == nil && u.Scheme != "" && u.Host != "" {
+		return file
+	}
+
+	path := filepath.ToSlash(file)
+	if filepath.IsAbs(file) {
+		return (&url.URL{Scheme: "file", Path: "/" + strings.TrimPrefix(path, "/")}).String()
+	}
+
+	return (&url.URL{Path: path}).String()
+}
@@ -0,0 +1,82 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func TestWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, testResults()); err != nil {
+		t.Fatalf("failed to write SARIF log: %v", err)
+	}
+
+	checkGolden(t, "report.sarif", buf.Bytes())
+	checkSARIFSchema(t, buf.Bytes())
+}
+
+func TestWriteSARIFWithoutResults(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, nil); err != nil {
+		t.Fatalf("failed to write SARIF log: %v", err)
+	}
+
+	checkGolden(t, "empty.sarif", buf.Bytes())
+	checkSARIFSchema(t, buf.Bytes())
+}
+
+func TestArtifactURI(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want string
+	}{
+		{name: "relative path", file: "records/agent.json", want: "records/agent.json"},
+		{name: "space", file: "records/my agent.json", want: "records/my%20agent.json"},
+		{name: "percent", file: "records/100%.json", want: "records/100%25.json"},
+		{name: "colon", file: "agent:v1.json", want: "./agent:v1.json"},
+		{name: "stdin", file: StdinFile, want: stdinURI},
+		{name: "uri", file: "https://example.com/records/agent.json", want: "https://example.com/records/agent.json"},
+	}
+
+	if runtime.GOOS != "windows" {
+		tests = append(tests, struct {
+			name string
+			file string
+			want string
+		}{name: "absolute path", file: "/records/my agent.json", want: "file:///records/my%20agent.json"})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := artifactURI(tt.file); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// checkSARIFSchema validates a SARIF log against the SARIF 2.1.0 schema.
+func checkSARIFSchema(t *testing.T, log []byte) {
+	t.Helper()
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader("file://./testdata/sarif-schema-2.1.0.json"))
+	if err != nil {
+		t.Fatalf("failed to load SARIF schema: %v", err)
+	}
+
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(log))
+	if err != nil {
+		t.Fatalf("failed to validate SARIF log: %v", err)
+	}
+
+	for _, resultErr := range result.Errors() {
+		t.Errorf("SARIF log does not match the schema: %s", resultErr)
+	}
+}
@@ -6,83 +6,69 @@ package server
 import (
 	"context"
 	"fmt"
-	"log/slog"
-	"net"
-	"os"
-	"os/signal"
-	"syscall"
 
 	validationv1grpc "buf.build/gen/go/agntcy/oasf-sdk/grpc/go/validation/v1/validationv1grpc"
+	commonserver "github.com/agntcy/oasf-sdk/common/server"
 	"github.com/agntcy/oasf-sdk/validation/config"
 	controllerv1 "github.com/agntcy/oasf-sdk/validation/controller/v1"
+	gatewayv1 "github.com/agntcy/oasf-sdk/validation/gateway/v1"
+	"github.com/agntcy/oasf-sdk/validation/service"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
 )
 
-type Server struct {
-	cfg        *config.Config
-	grpcServer *grpc.Server
-}
-
 func Run(ctx context.Context, cfg *config.Config) error {
-	server, err := NewServer(ctx, cfg)
+	stopTracing, err := commonserver.SetupTracing(ctx, cfg.Tracing)
 	if err != nil {
-		return fmt.Errorf("failed to create server: %w", err)
+		return fmt.Errorf("failed to set up tracing: %w", err)
 	}
+	defer stopTracing()
 
-	if err := server.start(); err != nil {
-		return fmt.Errorf("failed to start server: %w", err)
+	service, err := NewService(cfg)
+	if err != nil {
+		return err
 	}
-	defer server.close()
-
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 
-	select {
-	case <-ctx.Done():
-		return fmt.Errorf("stopping server due to context cancellation: %w", ctx.Err())
-	case sig := <-sigCh:
-		return fmt.Errorf("stopping server due to signal: %v", sig)
-	}
+	return commonserver.Run(ctx, cfg.Config, service)
 }
 
-func NewServer(ctx context.Context, cfg *config.Config) (*Server, error) {
-	slog.Info("Creating new server", "config", cfg)
-
-	server := &Server{
-		cfg:        cfg,
-		grpcServer: grpc.NewServer(),
-	}
-
-	controller, err := controllerv1.NewValidationController()
+// NewService creates the validation service, to be served alone or along with other services.
+// The options are applied after the configuration.
+func NewService(cfg *config.Config, opts ...service.Option) (commonserver.Service, error) {
+	controller, err := controllerv1.NewValidationController(cfg, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create validation controller: %w", err)
+		return commonserver.Service{}, fmt.Errorf("failed to create validation controller: %w", err)
 	}
 
-	validationv1grpc.RegisterValidationServiceServer(server.grpcServer, controller)
-
-	reflection.Register(server.grpcServer)
-
-	return server, nil
+	return commonserver.Service{
+		Name: validationv1grpc.ValidationService_ServiceDesc.ServiceName,
+		Register: func(registrar grpc.ServiceRegistrar) {
+			validationv1grpc.RegisterValidationServiceServer(registrar, controller)
+		},
+		RegisterGateway: gatewayv1.RegisterValidationServiceHandler,
+		OpenAPIPath:     gatewayv1.OpenAPIPath,
+		OpenAPI:         gatewayv1.OpenAPI,
+	}, nil
 }
 
-func (s Server) close() {
-	s.grpcServer.GracefulStop()
-}
+// RegisterValidationService registers the validation service on registrar, eg. an existing gRPC server.
+// The default configuration is used when cfg is nil, and the options are applied after the configuration.
+func RegisterValidationService(registrar grpc.ServiceRegistrar, cfg *config.Config, opts ...service.Option) error {
+	if cfg == nil {
+		cfg = &config.Config{
+			SchemaVersionFallback: config.DefaultSchemaVersionFallback,
+			StreamWorkers:         config.DefaultStreamWorkers,
+			StreamMaxInFlight:     config.DefaultStreamMaxInFlight,
+			MaxSchemaSize:         config.DefaultMaxSchemaSize,
+			SchemaCacheTTL:        config.DefaultSchemaCacheTTL,
+		}
+	}
 
-func (s Server) start() error {
-	listen, err := net.Listen("tcp", s.cfg.ListenAddress)
+	controller, err := controllerv1.NewValidationController(cfg, opts...)
 	if err != nil {
-		return fmt.Errorf("failed to listen on %s: %w", s.cfg.ListenAddress, err)
+		return fmt.Errorf("failed to create validation controller: %w", err)
 	}
 
-	go func() {
-		slog.Info("Starting server", "address", s.cfg.ListenAddress)
-
-		if err := s.grpcServer.Serve(listen); err != nil {
-			slog.Error("Server stopped unexpectedly", "error", err)
-		}
-	}()
+	validationv1grpc.RegisterValidationServiceServer(registrar, controller)
 
 	return nil
 }
@@ -0,0 +1,177 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// messageToDocument converts a proto message into a generic JSON document
+// that can be validated against a JSON schema.
+//
+// protojson encodes 64-bit integers as strings, which JSON schemas describe
+// as integers. The proto descriptor is used to turn those strings back into
+// numbers, while numbers that were already encoded as such (for example
+// inside extension data) are kept as json.Number to preserve precision.
+func messageToDocument(msg proto.Message) (map[string]any, error) {
+	marshaler := &protojson.MarshalOptions{
+		UseProtoNames: true,
+	}
+	jsonBytes, err := marshaler.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message to JSON: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+
+	var document map[string]any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+
+	coerceMessage(msg.ProtoReflect().Descriptor(), document)
+
+	return document, nil
+}
+
+// coerceMessage walks a JSON object produced by protojson for the given
+// message descriptor and converts string-encoded 64-bit integers into numbers.
+func coerceMessage(md protoreflect.MessageDescriptor, data map[string]any) {
+	if data == nil {
+		return
+	}
+
+	if md.FullName() == "google.protobuf.Any" {
+		coerceAny(data)
+
+		return
+	}
+
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+
+		name := string(fd.Name())
+		value, ok := data[name]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+			entries, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			for key, entry := range entries {
+				entries[key] = coerceValue(fd.MapValue(), entry)
+			}
+		case fd.IsList():
+			items, ok := value.([]any)
+			if !ok {
+				continue
+			}
+
+			for idx, item := range items {
+				items[idx] = coerceValue(fd, item)
+			}
+		default:
+			data[name] = coerceValue(fd, value)
+		}
+	}
+}
+
+// coerceValue converts a single (non-repeated) JSON value of the given field.
+func coerceValue(fd protoreflect.FieldDescriptor, value any) any {
+	switch fd.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return coerceInt(value, true)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return coerceInt(value, false)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return coerceWellKnown(fd.Message(), value)
+	default:
+		return value
+	}
+}
+
+// coerceWellKnown handles message values, including the well-known types
+// whose JSON representation differs from a regular message.
+func coerceWellKnown(md protoreflect.MessageDescriptor, value any) any {
+	switch md.FullName() {
+	case "google.protobuf.Int64Value":
+		return coerceInt(value, true)
+	case "google.protobuf.UInt64Value":
+		return coerceInt(value, false)
+	case "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue":
+		// Struct values are encoded as plain JSON and never use strings for numbers.
+		return value
+	}
+
+	if object, ok := value.(map[string]any); ok {
+		coerceMessage(md, object)
+	}
+
+	return value
+}
+
+// coerceAny resolves the embedded message type of a google.protobuf.Any
+// and coerces its fields. Unknown types are left untouched.
+func coerceAny(data map[string]any) {
+	typeURL, ok := data["@type"].(string)
+	if !ok {
+		return
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(typeURL)
+	if err != nil {
+		return
+	}
+
+	md := mt.Descriptor()
+
+	// Well-known types are wrapped in a "value" field, all others are inlined.
+	if md.FullName().Parent() == "google.protobuf" {
+		if value, ok := data["value"]; ok {
+			data["value"] = coerceWellKnown(md, value)
+		}
+
+		return
+	}
+
+	coerceMessage(md, data)
+}
+
+// coerceInt converts a string-encoded integer into a json.Number.
+// Values that are not valid integers are returned unchanged so that the
+// schema reports them.
+func coerceInt(value any, signed bool) any {
+	str, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	var err error
+	if signed {
+		_, err = strconv.ParseInt(str, 10, 64)
+	} else {
+		_, err = strconv.ParseUint(str, 10, 64)
+	}
+
+	if err != nil {
+		return value
+	}
+
+	return json.Number(str)
+}
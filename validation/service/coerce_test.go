@@ -0,0 +1,250 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func descriptorOf(msg proto.Message) protoreflect.MessageDescriptor {
+	return msg.ProtoReflect().Descriptor()
+}
+
+func TestCoerceInt(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  any
+		signed bool
+		want   any
+	}{
+		{name: "signed", value: "-42", signed: true, want: json.Number("-42")},
+		{name: "signed max", value: "9223372036854775807", signed: true, want: json.Number("9223372036854775807")},
+		{name: "signed overflow", value: "9223372036854775808", signed: true, want: "9223372036854775808"},
+		{name: "unsigned max", value: "18446744073709551615", want: json.Number("18446744073709551615")},
+		{name: "unsigned negative", value: "-1", want: "-1"},
+		{name: "not an integer", value: "1.5", signed: true, want: "1.5"},
+		{name: "number", value: json.Number("7"), signed: true, want: json.Number("7")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := coerceInt(tt.value, tt.signed); got != tt.want {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoerceWellKnown(t *testing.T) {
+	tests := []struct {
+		name       string
+		descriptor protoreflect.MessageDescriptor
+		value      any
+		want       any
+	}{
+		{
+			name:       "int64 value",
+			descriptor: descriptorOf(&wrapperspb.Int64Value{}),
+			value:      "-5",
+			want:       json.Number("-5"),
+		},
+		{
+			name:       "uint64 value",
+			descriptor: descriptorOf(&wrapperspb.UInt64Value{}),
+			value:      "18446744073709551615",
+			want:       json.Number("18446744073709551615"),
+		},
+		{
+			name:       "int32 value",
+			descriptor: descriptorOf(&wrapperspb.Int32Value{}),
+			value:      json.Number("5"),
+			want:       json.Number("5"),
+		},
+		{
+			name:       "duration",
+			descriptor: descriptorOf(&durationpb.Duration{}),
+			value:      "1.5s",
+			want:       "1.5s",
+		},
+		{
+			name:       "struct",
+			descriptor: descriptorOf(&structpb.Struct{}),
+			value:      map[string]any{"size": "5"},
+			want:       map[string]any{"size": "5"},
+		},
+		{
+			name:       "value",
+			descriptor: descriptorOf(&structpb.Value{}),
+			value:      "5",
+			want:       "5",
+		},
+		{
+			name:       "list value",
+			descriptor: descriptorOf(&structpb.ListValue{}),
+			value:      []any{"5"},
+			want:       []any{"5"},
+		},
+		{
+			name:       "message",
+			descriptor: descriptorOf(&objectsv3.Locator{}),
+			value:      map[string]any{"size": "5", "url": "https://example.com"},
+			want:       map[string]any{"size": json.Number("5"), "url": "https://example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := coerceWellKnown(tt.descriptor, tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoerceAny(t *testing.T) {
+	locatorURL := "type.googleapis.com/" + string(descriptorOf(&objectsv3.Locator{}).FullName())
+
+	tests := []struct {
+		name  string
+		value map[string]any
+		want  map[string]any
+	}{
+		{
+			name:  "well-known type",
+			value: map[string]any{"@type": "type.googleapis.com/google.protobuf.Int64Value", "value": "5"},
+			want:  map[string]any{"@type": "type.googleapis.com/google.protobuf.Int64Value", "value": json.Number("5")},
+		},
+		{
+			name:  "message",
+			value: map[string]any{"@type": locatorURL, "size": "5"},
+			want:  map[string]any{"@type": locatorURL, "size": json.Number("5")},
+		},
+		{
+			name:  "unknown type",
+			value: map[string]any{"@type": "type.googleapis.com/example.Unknown", "size": "5"},
+			want:  map[string]any{"@type": "type.googleapis.com/example.Unknown", "size": "5"},
+		},
+		{
+			name:  "no type",
+			value: map[string]any{"size": "5"},
+			want:  map[string]any{"size": "5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coerceMessage(descriptorOf(&anypb.Any{}), tt.value)
+
+			if !reflect.DeepEqual(tt.value, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.value, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageToDocument(t *testing.T) {
+	data, err := structpb.NewStruct(map[string]any{"count": "9007199254740993"})
+	if err != nil {
+		t.Fatalf("failed to create extension data: %v", err)
+	}
+
+	record := objectsv3.Record_builder{
+		Name:       "example",
+		Locators:   []*objectsv3.Locator{objectsv3.Locator_builder{Size: proto.Uint64(18446744073709551615)}.Build()},
+		Extensions: []*objectsv3.Extension{objectsv3.Extension_builder{Name: "example", Data: data}.Build()},
+	}.Build()
+
+	document, err := messageToDocument(record)
+	if err != nil {
+		t.Fatalf("failed to convert record: %v", err)
+	}
+
+	locator := document["locators"].([]any)[0].(map[string]any)
+	if got := locator["size"]; got != json.Number("18446744073709551615") {
+		t.Errorf("got locator size %#v, want a number", got)
+	}
+
+	// Extension data is plain JSON, whose strings are never integers.
+	extensionData := document["extensions"].([]any)[0].(map[string]any)["data"].(map[string]any)
+	if got := extensionData["count"]; got != "9007199254740993" {
+		t.Errorf("got extension count %#v, want the string", got)
+	}
+}
+
+// extensionDataSchema requires the extension data to have an integer count and a string id.
+const extensionDataSchema = `{
+	"type": "object",
+	"properties": {
+		"extensions": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"properties": {
+					"data": {
+						"type": "object",
+						"properties": {
+							"count": {"type": "integer"},
+							"id": {"type": "string"}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestValidateExtensionDataTypes(t *testing.T) {
+	validator, err := NewValidationService(
+		WithoutEmbeddedSchemas(),
+		WithSchemaFS(fstest.MapFS{"v9.9.9.json": {Data: []byte(extensionDataSchema)}}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		data      map[string]any
+		wantValid bool
+	}{
+		{name: "number", data: map[string]any{"count": 42, "id": "42"}, wantValid: true},
+		{name: "string integer", data: map[string]any{"count": "100"}},
+		{name: "string", data: map[string]any{"count": "many"}},
+		{name: "number for a string", data: map[string]any{"id": 42}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := structpb.NewStruct(tt.data)
+			if err != nil {
+				t.Fatalf("failed to create extension data: %v", err)
+			}
+
+			record := objectsv3.Record_builder{
+				SchemaVersion: "v9.9.9",
+				Extensions:    []*objectsv3.Extension{objectsv3.Extension_builder{Name: "example", Data: data}.Build()},
+			}.Build()
+
+			result, err := validator.Validate(t.Context(), record)
+			if err != nil {
+				t.Fatalf("failed to validate record: %v", err)
+			}
+
+			if result.IsValid != tt.wantValid {
+				t.Errorf("got valid %v with errors %v, want valid %v", result.IsValid, result.Errors, tt.wantValid)
+			}
+		})
+	}
+}
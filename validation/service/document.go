@@ -0,0 +1,474 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// syntaxKeyword is reported for issues found while parsing a document.
+	syntaxKeyword = "syntax"
+
+	// maxDocumentNodes limits the number of nodes a YAML document can expand to through aliases.
+	maxDocumentNodes = 1_000_000
+)
+
+var yamlLinePattern = regexp.MustCompile(`line (\d+)`)
+
+// duplicateKeyError reports an object key that is defined more than once, which JSON schemas cannot see
+// as only one of the values is kept.
+type duplicateKeyError struct {
+	key string
+	pos position
+}
+
+func (e *duplicateKeyError) Error() string {
+	return fmt.Sprintf("key %q is already defined", e.key)
+}
+
+// ValidateDocument validates a raw JSON or YAML record document as-is against the schema.
+// Every issue carries the position of the offending value in the original document.
+func (v ValidationService) ValidateDocument(ctx context.Context, document []byte, opts ...ValidateOption) (*ValidationResult, error) {
+	o := newValidateOptions(opts)
+
+	result, err := v.validateRawDocument(ctx, document, o)
+	observeValidation(result, o.schemaURL, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if o.sarif {
+		if err := result.addSARIF(o.documentURI); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateRecordDocument validates the document of a request. It is the counterpart of ValidateDocument
+// for the requests of the gRPC service.
+func (v ValidationService) ValidateRecordDocument(ctx context.Context, req *validationv1.ValidateRecordDocumentRequest) (*ValidationResult, error) {
+	opts := append(
+		requestOptions(req.GetSchemaUrl(), req.GetDetectSchemaVersion(), req.GetIncludeSarif(), req.GetDocumentUri()),
+		WithDocumentFormat(req.GetFormat()),
+	)
+
+	return v.ValidateDocument(ctx, req.GetDocument(), opts...)
+}
+
+func (v ValidationService) validateRawDocument(ctx context.Context, data []byte, o validateOptions) (*ValidationResult, error) {
+	document, issue := parseDocument(data, o.format)
+	if issue != nil {
+		return &ValidationResult{
+			Errors: []string{issue.Message},
+			Issues: []*validationv1.ValidationIssue{issue},
+		}, nil
+	}
+
+	return v.validate(ctx, document.value, document, o.schemaURL, o.detectSchemaVersion)
+}
+
+// position is a 1-based line and column in a document.
+type position struct {
+	line   int
+	column int
+}
+
+// parsedDocument is a decoded record document along with the positions
+// of its values, indexed by JSON pointer.
+type parsedDocument struct {
+	value any
+
+	// values holds the position of every value in the document.
+	values map[string]position
+
+	// keys holds the position of the key of every object property.
+	keys map[string]position
+
+	nodes int
+}
+
+func newParsedDocument() *parsedDocument {
+	return &parsedDocument{
+		values: make(map[string]position),
+		keys:   make(map[string]position),
+	}
+}
+
+// issue converts a schema validation error into an issue positioned in the document.
+// Issues of a nil document carry no position.
+func (d *parsedDocument) issue(resultErr gojsonschema.ResultError) *validationv1.ValidationIssue {
+	path := contextPointer(resultErr.Context())
+	if d == nil {
+		return &validationv1.ValidationIssue{
+			Message: resultErr.Description(),
+			Path:    path,
+			Keyword: schemaKeyword(resultErr),
+		}
+	}
+
+	pos := d.values[path]
+
+	// Point at the offending key rather than the object that contains it.
+	if property, ok := resultErr.Details()["property"].(string); ok && resultErr.Type() == "additional_property_not_allowed" {
+		propertyPath := path + "/" + escapePointer(property)
+		if keyPos, ok := d.keys[propertyPath]; ok {
+			path, pos = propertyPath, keyPos
+		}
+	}
+
+	return &validationv1.ValidationIssue{
+		Message: resultErr.Description(),
+		Path:    path,
+		Keyword: schemaKeyword(resultErr),
+		Line:    uint32(pos.line),
+		Column:  uint32(pos.column),
+	}
+}
+
+// parseDocument decodes a JSON or YAML document. Syntax errors are returned as an issue.
+func parseDocument(data []byte, format validationv1.DocumentFormat) (*parsedDocument, *validationv1.ValidationIssue) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, &validationv1.ValidationIssue{
+			Message: "document cannot be empty",
+			Keyword: syntaxKeyword,
+		}
+	}
+
+	if format == validationv1.DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED {
+		format = detectFormat(data)
+	}
+
+	if format == validationv1.DocumentFormat_DOCUMENT_FORMAT_JSON {
+		return parseJSONDocument(data)
+	}
+
+	return parseYAMLDocument(data)
+}
+
+// detectFormat treats documents starting with an object or array as JSON and everything else as YAML.
+func detectFormat(data []byte) validationv1.DocumentFormat {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return validationv1.DocumentFormat_DOCUMENT_FORMAT_JSON
+	}
+
+	return validationv1.DocumentFormat_DOCUMENT_FORMAT_YAML
+}
+
+type jsonParser struct {
+	data     []byte
+	decoder  *json.Decoder
+	document *parsedDocument
+}
+
+func parseJSONDocument(data []byte) (*parsedDocument, *validationv1.ValidationIssue) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	parser := &jsonParser{
+		data:     data,
+		decoder:  decoder,
+		document: newParsedDocument(),
+	}
+
+	value, err := parser.parseValue("")
+
+	var trailingOffset int
+	if err == nil {
+		trailingOffset = parser.nextOffset()
+		if _, tokenErr := decoder.Token(); !errors.Is(tokenErr, io.EOF) {
+			err = errors.New("invalid character after top-level value")
+		}
+	}
+
+	if err != nil {
+		offset := int(decoder.InputOffset())
+
+		var syntaxErr *json.SyntaxError
+		var duplicateErr *duplicateKeyError
+
+		switch {
+		case errors.Is(err, io.ErrUnexpectedEOF), errors.As(err, &syntaxErr) && syntaxErr.Error() == "unexpected end of JSON input":
+			offset = len(data)
+		case syntaxErr != nil:
+			// The offset of a syntax error includes the offending character.
+			offset = int(syntaxErr.Offset) - 1
+		case trailingOffset > 0:
+			offset = trailingOffset
+		}
+
+		pos := offsetPosition(data, offset)
+		if errors.As(err, &duplicateErr) {
+			pos = duplicateErr.pos
+		}
+
+		return nil, &validationv1.ValidationIssue{
+			Message: fmt.Sprintf("invalid JSON document: %v", err),
+			Keyword: syntaxKeyword,
+			Line:    uint32(pos.line),
+			Column:  uint32(pos.column),
+		}
+	}
+
+	parser.document.value = value
+
+	return parser.document, nil
+}
+
+// nextOffset returns the offset of the next token in the input.
+func (p *jsonParser) nextOffset() int {
+	offset := int(p.decoder.InputOffset())
+	for offset < len(p.data) && strings.IndexByte(" \t\r\n,:", p.data[offset]) >= 0 {
+		offset++
+	}
+
+	return offset
+}
+
+func (p *jsonParser) parseValue(pointer string) (any, error) {
+	p.document.values[pointer] = offsetPosition(p.data, p.nextOffset())
+
+	token, err := p.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		object := make(map[string]any)
+		for p.decoder.More() {
+			keyPos := offsetPosition(p.data, p.nextOffset())
+
+			keyToken, err := p.decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", keyToken)
+			}
+
+			if _, ok := object[key]; ok {
+				return nil, &duplicateKeyError{key: key, pos: keyPos}
+			}
+
+			keyPointer := pointer + "/" + escapePointer(key)
+			p.document.keys[keyPointer] = keyPos
+
+			object[key], err = p.parseValue(keyPointer)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := p.decoder.Token(); err != nil {
+			return nil, err
+		}
+
+		return object, nil
+	case '[':
+		array := []any{}
+		for p.decoder.More() {
+			item, err := p.parseValue(pointer + "/" + strconv.Itoa(len(array)))
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, item)
+		}
+
+		if _, err := p.decoder.Token(); err != nil {
+			return nil, err
+		}
+
+		return array, nil
+	default:
+		return nil, fmt.Errorf("unexpected delimiter %v", delim)
+	}
+}
+
+func parseYAMLDocument(data []byte) (*parsedDocument, *validationv1.ValidationIssue) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		issue := &validationv1.ValidationIssue{
+			Message: fmt.Sprintf("invalid YAML document: %v", err),
+			Keyword: syntaxKeyword,
+		}
+
+		if match := yamlLinePattern.FindStringSubmatch(err.Error()); match != nil {
+			line, _ := strconv.ParseUint(match[1], 10, 32)
+			issue.Line = uint32(line)
+		}
+
+		return nil, issue
+	}
+
+	document := newParsedDocument()
+
+	value, err := document.addYAMLNode(&root, "")
+	if err != nil {
+		issue := &validationv1.ValidationIssue{
+			Message: fmt.Sprintf("invalid YAML document: %v", err),
+			Keyword: syntaxKeyword,
+		}
+
+		var duplicateErr *duplicateKeyError
+		if errors.As(err, &duplicateErr) {
+			issue.Line = uint32(duplicateErr.pos.line)
+			issue.Column = uint32(duplicateErr.pos.column)
+		}
+
+		return nil, issue
+	}
+
+	document.value = value
+
+	return document, nil
+}
+
+func (d *parsedDocument) addYAMLNode(node *yaml.Node, pointer string) (any, error) {
+	d.nodes++
+	if d.nodes > maxDocumentNodes {
+		return nil, errors.New("document is too large")
+	}
+
+	if node.Kind != yaml.DocumentNode {
+		d.values[pointer] = position{line: node.Line, column: node.Column}
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return d.addYAMLNode(node.Content[0], pointer)
+	case yaml.AliasNode:
+		return d.addYAMLNode(node.Alias, pointer)
+	case yaml.MappingNode:
+		object := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			keyPos := position{line: keyNode.Line, column: keyNode.Column}
+
+			if _, ok := object[keyNode.Value]; ok {
+				return nil, &duplicateKeyError{key: keyNode.Value, pos: keyPos}
+			}
+
+			keyPointer := pointer + "/" + escapePointer(keyNode.Value)
+			d.keys[keyPointer] = keyPos
+
+			value, err := d.addYAMLNode(valueNode, keyPointer)
+			if err != nil {
+				return nil, err
+			}
+
+			object[keyNode.Value] = value
+		}
+
+		return object, nil
+	case yaml.SequenceNode:
+		array := make([]any, 0, len(node.Content))
+		for i, itemNode := range node.Content {
+			item, err := d.addYAMLNode(itemNode, pointer+"/"+strconv.Itoa(i))
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, item)
+		}
+
+		return array, nil
+	case yaml.ScalarNode:
+		return yamlScalar(node), nil
+	default:
+		return nil, fmt.Errorf("unsupported YAML node at line %d", node.Line)
+	}
+}
+
+// yamlScalar converts a YAML scalar into the matching JSON value.
+// Scalars that have no JSON equivalent, such as timestamps, are kept as strings.
+func yamlScalar(node *yaml.Node) any {
+	switch node.ShortTag() {
+	case "!!null":
+		return nil
+	case "!!bool":
+		var value bool
+		if err := node.Decode(&value); err == nil {
+			return value
+		}
+	case "!!int":
+		var value any
+		if err := node.Decode(&value); err == nil {
+			return value
+		}
+	case "!!float":
+		var value float64
+		if err := node.Decode(&value); err == nil && !math.IsInf(value, 0) && !math.IsNaN(value) {
+			return value
+		}
+	}
+
+	return node.Value
+}
+
+// offsetPosition converts a byte offset into a line and column.
+func offsetPosition(data []byte, offset int) position {
+	offset = min(max(offset, 0), len(data))
+
+	prefix := data[:offset]
+	line := bytes.Count(prefix, []byte{'\n'}) + 1
+	lineStart := bytes.LastIndexByte(prefix, '\n') + 1
+
+	return position{
+		line:   line,
+		column: utf8.RuneCount(prefix[lineStart:]) + 1,
+	}
+}
+
+// contextPointer converts a gojsonschema context into a JSON pointer.
+func contextPointer(context *gojsonschema.JsonContext) string {
+	if context == nil {
+		return ""
+	}
+
+	// Split on a separator that is not expected in document keys.
+	parts := strings.Split(context.String("\x00"), "\x00")
+
+	var pointer strings.Builder
+	for _, part := range parts[1:] {
+		pointer.WriteString("/")
+		pointer.WriteString(escapePointer(part))
+	}
+
+	return pointer.String()
+}
+
+func escapePointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
@@ -0,0 +1,311 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func TestParseDocumentPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		document   string
+		wantValues map[string]position
+		wantKeys   map[string]position
+	}{
+		{
+			name:     "json",
+			document: "{\n  \"name\": \"example\",\n  \"skills\": [1, {\"id\": 2}]\n}",
+			wantValues: map[string]position{
+				"":             {line: 1, column: 1},
+				"/name":        {line: 2, column: 11},
+				"/skills":      {line: 3, column: 13},
+				"/skills/0":    {line: 3, column: 14},
+				"/skills/1":    {line: 3, column: 17},
+				"/skills/1/id": {line: 3, column: 24},
+			},
+			wantKeys: map[string]position{
+				"/name":        {line: 2, column: 3},
+				"/skills":      {line: 3, column: 3},
+				"/skills/1/id": {line: 3, column: 18},
+			},
+		},
+		{
+			name:     "json escaped keys",
+			document: `{"a/b": {"m~n": "é"}, "c": 1}`,
+			wantValues: map[string]position{
+				"":           {line: 1, column: 1},
+				"/a~1b":      {line: 1, column: 9},
+				"/a~1b/m~0n": {line: 1, column: 17},
+				"/c":         {line: 1, column: 28},
+			},
+			wantKeys: map[string]position{
+				"/a~1b":      {line: 1, column: 2},
+				"/a~1b/m~0n": {line: 1, column: 10},
+				"/c":         {line: 1, column: 23},
+			},
+		},
+		{
+			name:     "yaml",
+			document: "name: example\nskills:\n  - id: 1\n  - é/x: 2\n",
+			wantValues: map[string]position{
+				"":               {line: 1, column: 1},
+				"/name":          {line: 1, column: 7},
+				"/skills":        {line: 3, column: 3},
+				"/skills/0":      {line: 3, column: 5},
+				"/skills/0/id":   {line: 3, column: 9},
+				"/skills/1":      {line: 4, column: 5},
+				"/skills/1/é~1x": {line: 4, column: 10},
+			},
+			wantKeys: map[string]position{
+				"/name":          {line: 1, column: 1},
+				"/skills":        {line: 2, column: 1},
+				"/skills/0/id":   {line: 3, column: 5},
+				"/skills/1/é~1x": {line: 4, column: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, issue := parseDocument([]byte(tt.document), validationv1.DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED)
+			if issue != nil {
+				t.Fatalf("failed to parse document: %v", issue)
+			}
+
+			if !maps.Equal(document.values, tt.wantValues) {
+				t.Errorf("got value positions %v, want %v", document.values, tt.wantValues)
+			}
+
+			if !maps.Equal(document.keys, tt.wantKeys) {
+				t.Errorf("got key positions %v, want %v", document.keys, tt.wantKeys)
+			}
+		})
+	}
+}
+
+func TestParseJSONDocumentValues(t *testing.T) {
+	document, issue := parseJSONDocument([]byte(`{"size": 9007199254740993, "tags": [], "empty": {}, "none": null}`))
+	if issue != nil {
+		t.Fatalf("failed to parse document: %v", issue)
+	}
+
+	want := map[string]any{
+		"size":  json.Number("9007199254740993"),
+		"tags":  []any{},
+		"empty": map[string]any{},
+		"none":  nil,
+	}
+
+	if !reflect.DeepEqual(document.value, want) {
+		t.Errorf("got document %#v, want %#v", document.value, want)
+	}
+}
+
+func TestParseDocumentErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		document    string
+		format      validationv1.DocumentFormat
+		wantMessage string
+		wantLine    uint32
+		wantColumn  uint32
+	}{
+		{
+			name:        "empty",
+			document:    " \n",
+			wantMessage: "document cannot be empty",
+		},
+		{
+			name:        "json invalid character",
+			document:    "{\"a\": 1,\n  \"b\" 2}",
+			wantMessage: "invalid character '2' after object key",
+			wantLine:    2,
+			wantColumn:  7,
+		},
+		{
+			name:        "json unexpected end",
+			document:    "{\"a\": [1,\n  2",
+			wantMessage: "unexpected end of JSON input",
+			wantLine:    2,
+			wantColumn:  4,
+		},
+		{
+			name:        "json trailing content",
+			document:    "{}\n  x",
+			wantMessage: "invalid character after top-level value",
+			wantLine:    2,
+			wantColumn:  3,
+		},
+		{
+			name:        "json duplicate key",
+			document:    "{\"a\": 1,\n  \"a\": 2}",
+			wantMessage: `key "a" is already defined`,
+			wantLine:    2,
+			wantColumn:  3,
+		},
+		{
+			name:        "json forced format",
+			document:    "name: example",
+			format:      validationv1.DocumentFormat_DOCUMENT_FORMAT_JSON,
+			wantMessage: "invalid character 'a' in literal null",
+			wantLine:    1,
+			wantColumn:  2,
+		},
+		{
+			name:        "yaml indentation",
+			document:    "a: 1\n\tb: 2\n",
+			wantMessage: "found a tab character",
+			wantLine:    2,
+		},
+		{
+			name:        "yaml duplicate key",
+			document:    "a:\n  b: 1\n  b: 2\n",
+			wantMessage: `key "b" is already defined`,
+			wantLine:    3,
+			wantColumn:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, issue := parseDocument([]byte(tt.document), tt.format)
+			if issue == nil {
+				t.Fatalf("got document %v, want a syntax issue", document.value)
+			}
+
+			if !strings.Contains(issue.GetMessage(), tt.wantMessage) || issue.GetKeyword() != syntaxKeyword {
+				t.Errorf("got issue %v, want a syntax issue containing %q", issue, tt.wantMessage)
+			}
+
+			if issue.GetLine() != tt.wantLine || issue.GetColumn() != tt.wantColumn {
+				t.Errorf("got position %d:%d, want %d:%d", issue.GetLine(), issue.GetColumn(), tt.wantLine, tt.wantColumn)
+			}
+		})
+	}
+}
+
+func TestOffsetPosition(t *testing.T) {
+	data := []byte("ab\néé\n\nx")
+
+	tests := []struct {
+		offset int
+		want   position
+	}{
+		{offset: -1, want: position{line: 1, column: 1}},
+		{offset: 0, want: position{line: 1, column: 1}},
+		{offset: 2, want: position{line: 1, column: 3}},
+		{offset: 3, want: position{line: 2, column: 1}},
+		// Columns count characters rather than bytes.
+		{offset: 7, want: position{line: 2, column: 3}},
+		{offset: 8, want: position{line: 3, column: 1}},
+		{offset: 9, want: position{line: 4, column: 1}},
+		{offset: 100, want: position{line: 4, column: 2}},
+	}
+
+	for _, tt := range tests {
+		if got := offsetPosition(data, tt.offset); got != tt.want {
+			t.Errorf("got position %v for offset %d, want %v", got, tt.offset, tt.want)
+		}
+	}
+}
+
+func TestContextPointer(t *testing.T) {
+	root := gojsonschema.NewJsonContext("(root)", nil)
+
+	tests := []struct {
+		name    string
+		context *gojsonschema.JsonContext
+		want    string
+	}{
+		{name: "nil", want: ""},
+		{name: "root", context: root, want: ""},
+		{name: "nested", context: gojsonschema.NewJsonContext("0", gojsonschema.NewJsonContext("skills", root)), want: "/skills/0"},
+		{name: "slash", context: gojsonschema.NewJsonContext("a/b", root), want: "/a~1b"},
+		{name: "tilde", context: gojsonschema.NewJsonContext("m~n", root), want: "/m~0n"},
+		{name: "both", context: gojsonschema.NewJsonContext("~/", root), want: "/~0~1"},
+		{name: "dot", context: gojsonschema.NewJsonContext("a.b", root), want: "/a.b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := contextPointer(tt.context); got != tt.want {
+				t.Errorf("got pointer %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDocumentIssuePositions(t *testing.T) {
+	const schema = `{
+		"type": "object",
+		"properties": {
+			"a/b": {"type": "object", "additionalProperties": false, "properties": {"m~n": {"type": "integer"}}}
+		}
+	}`
+
+	validator, err := NewValidationService(
+		WithoutEmbeddedSchemas(),
+		WithSchemaFS(fstest.MapFS{"v9.9.9.json": {Data: []byte(schema)}}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		document   string
+		wantPath   string
+		wantLine   uint32
+		wantColumn uint32
+	}{
+		{
+			name:       "json value",
+			document:   "{\n  \"schema_version\": \"v9.9.9\",\n  \"a/b\": {\"m~n\": \"x\"}\n}",
+			wantPath:   "/a~1b/m~0n",
+			wantLine:   3,
+			wantColumn: 18,
+		},
+		{
+			name:       "yaml value",
+			document:   "schema_version: v9.9.9\na/b:\n  m~n: x\n",
+			wantPath:   "/a~1b/m~0n",
+			wantLine:   3,
+			wantColumn: 8,
+		},
+		{
+			name:       "yaml additional property",
+			document:   "schema_version: v9.9.9\na/b:\n  m~n: 1\n  other: 2\n",
+			wantPath:   "/a~1b/other",
+			wantLine:   4,
+			wantColumn: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateDocument(t.Context(), []byte(tt.document))
+			if err != nil {
+				t.Fatalf("failed to validate document: %v", err)
+			}
+
+			if len(result.Issues) != 1 {
+				t.Fatalf("got issues %v, want a single issue", result.Issues)
+			}
+
+			issue := result.Issues[0]
+			if issue.GetPath() != tt.wantPath || issue.GetLine() != tt.wantLine || issue.GetColumn() != tt.wantColumn {
+				t.Errorf("got issue %v, want %s at %d:%d", issue, tt.wantPath, tt.wantLine, tt.wantColumn)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"fmt"
+)
+
+// Reasons of the ErrorInfo details of the errors returned by the validation server, which
+// clients can branch on.
+const (
+	ReasonSchemaVersionNotFound = "SCHEMA_VERSION_NOT_FOUND"
+	ReasonInvalidSchemaURL      = "INVALID_SCHEMA_URL"
+	ReasonSchemaURLUnavailable  = "SCHEMA_URL_UNAVAILABLE"
+	ReasonInvalidSchema         = "INVALID_SCHEMA"
+)
+
+// SchemaVersionError is returned when no embedded schema matches the schema version of a record.
+type SchemaVersionError struct {
+	Version           string
+	AvailableVersions []string
+}
+
+func (e *SchemaVersionError) Error() string {
+	return fmt.Sprintf("no schema found for version %s. Available versions: %v", e.Version, e.AvailableVersions)
+}
+
+// SchemaURLError is returned when the schema at a schema URL cannot be fetched.
+type SchemaURLError struct {
+	URL string
+
+	// StatusCode is the HTTP status code of the response, or 0 when no response was received.
+	StatusCode int
+
+	Err error
+}
+
+func (e *SchemaURLError) Error() string {
+	return fmt.Sprintf("schema URL validation failed: %v", e.Err)
+}
+
+func (e *SchemaURLError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidSchemaURLError is returned when a schema URL is not an HTTP or HTTPS URL.
+type InvalidSchemaURLError struct {
+	URL string
+	Err error
+}
+
+func (e *InvalidSchemaURLError) Error() string {
+	return fmt.Sprintf("invalid schema URL %q: %v", e.URL, e.Err)
+}
+
+func (e *InvalidSchemaURLError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidSchemaError is returned when the document at a schema URL is not a valid JSON schema.
+type InvalidSchemaError struct {
+	URL string
+	Err error
+}
+
+func (e *InvalidSchemaError) Error() string {
+	return fmt.Sprintf("schema URL validation failed: %v", e.Err)
+}
+
+func (e *InvalidSchemaError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,90 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Outcomes of a record validation.
+const (
+	outcomeValid   = "valid"
+	outcomeInvalid = "invalid"
+	outcomeError   = "error"
+)
+
+// Results of resolving a schema URL.
+const (
+	schemaURLCacheHit = "cache_hit"
+	schemaURLFetched  = "fetched"
+	schemaURLFailed   = "failed"
+)
+
+const (
+	// schemaVersionURL labels records validated against a schema URL rather than an embedded schema.
+	schemaVersionURL = "schema_url"
+
+	// schemaVersionUnknown labels records that could not be validated against any schema.
+	schemaVersionUnknown = "unknown"
+
+	// issueKeywordNone labels issues that are not tied to a schema keyword, eg. a missing record.
+	issueKeywordNone = "none"
+)
+
+var (
+	validatedRecords = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "oasf",
+		Subsystem: "validation",
+		Name:      "records_total",
+		Help:      "Number of records validated, by schema version and outcome.",
+	}, []string{"schema_version", "outcome"})
+
+	validationIssues = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "oasf",
+		Subsystem: "validation",
+		Name:      "issues_total",
+		Help:      "Number of validation issues, by failed schema keyword.",
+	}, []string{"keyword"})
+
+	schemaURLRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "oasf",
+		Subsystem: "validation",
+		Name:      "schema_url_requests_total",
+		Help:      "Number of schema URLs resolved, by result: cache_hit, fetched or failed.",
+	}, []string{"result"})
+)
+
+// observeValidation records the outcome of validating a record.
+func observeValidation(result *ValidationResult, schemaURL string, err error) {
+	if err != nil {
+		validatedRecords.WithLabelValues(schemaVersionUnknown, outcomeError).Inc()
+
+		return
+	}
+
+	version := result.SchemaVersion
+	switch {
+	case schemaURL != "":
+		version = schemaVersionURL
+	case version == "":
+		version = schemaVersionUnknown
+	}
+
+	outcome := outcomeValid
+	if !result.IsValid {
+		outcome = outcomeInvalid
+	}
+
+	validatedRecords.WithLabelValues(version, outcome).Inc()
+
+	for _, issue := range result.Issues {
+		keyword := issue.Keyword
+		if keyword == "" {
+			keyword = issueKeywordNone
+		}
+
+		validationIssues.WithLabelValues(keyword).Inc()
+	}
+}
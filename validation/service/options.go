@@ -0,0 +1,134 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"io/fs"
+	"net/http"
+	"time"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+)
+
+// Option configures a ValidationService.
+type Option func(*ValidationService)
+
+// WithVersionFallback sets the policy used for schema versions that are not available.
+func WithVersionFallback(policy VersionFallback) Option {
+	return func(v *ValidationService) {
+		v.versionFallback = policy
+	}
+}
+
+// WithHTTPClient sets the HTTP client that fetches schema URLs, eg. to go through a proxy.
+func WithHTTPClient(client *http.Client) Option {
+	return func(v *ValidationService) {
+		v.httpClient = client
+	}
+}
+
+// WithMaxSchemaSize sets the maximum size in bytes of the schema at a schema URL, which is
+// DefaultMaxSchemaSize by default. Larger schemas are rejected with an InvalidSchemaError.
+func WithMaxSchemaSize(size int64) Option {
+	return func(v *ValidationService) {
+		v.maxSchemaSize = size
+	}
+}
+
+// WithSchemaCacheTTL sets how long a schema fetched from a schema URL is reused, which is
+// DefaultSchemaCacheTTL by default. Schema URLs are fetched for every record when ttl is zero.
+func WithSchemaCacheTTL(ttl time.Duration) Option {
+	return func(v *ValidationService) {
+		v.schemaCacheTTL = ttl
+	}
+}
+
+// WithSchemaFS adds the schemas at the root of fsys, named after their version, eg. v0.7.0.json.
+// They replace the embedded schemas of the same version. Use os.DirFS to load schemas from a directory.
+func WithSchemaFS(fsys fs.FS) Option {
+	return func(v *ValidationService) {
+		v.schemaSources = append(v.schemaSources, fsys)
+	}
+}
+
+// WithoutEmbeddedSchemas validates records only against the schemas added with WithSchemaFS.
+func WithoutEmbeddedSchemas() Option {
+	return func(v *ValidationService) {
+		v.embeddedDisabled = true
+	}
+}
+
+// WithRules adds rules that records must follow on top of their schema.
+func WithRules(rules ...Rule) Option {
+	return func(v *ValidationService) {
+		v.rules = append(v.rules, rules...)
+	}
+}
+
+// ValidateOption configures the validation of a record.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	schemaURL           string
+	detectSchemaVersion bool
+	sarif               bool
+	documentURI         string
+	format              validationv1.DocumentFormat
+}
+
+// WithSchemaURL validates records against the schema at url rather than the schema of their version.
+func WithSchemaURL(url string) ValidateOption {
+	return func(o *validateOptions) {
+		o.schemaURL = url
+	}
+}
+
+// WithSchemaVersionDetection validates records without a schema version against the schema version
+// they conform to, rather than rejecting them.
+func WithSchemaVersionDetection() ValidateOption {
+	return func(o *validateOptions) {
+		o.detectSchemaVersion = true
+	}
+}
+
+// WithSARIF includes the SARIF log of the issues in the result. Issues are located in the file
+// at documentURI, which can be empty.
+func WithSARIF(documentURI string) ValidateOption {
+	return func(o *validateOptions) {
+		o.sarif = true
+		o.documentURI = documentURI
+	}
+}
+
+// WithDocumentFormat sets the format of the documents validated with ValidateDocument,
+// which is otherwise detected from their content.
+func WithDocumentFormat(format validationv1.DocumentFormat) ValidateOption {
+	return func(o *validateOptions) {
+		o.format = format
+	}
+}
+
+func newValidateOptions(opts []ValidateOption) validateOptions {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// requestOptions returns the options of the fields of a validation request.
+func requestOptions(schemaURL string, detectSchemaVersion, includeSARIF bool, documentURI string) []ValidateOption {
+	opts := []ValidateOption{WithSchemaURL(schemaURL)}
+
+	if detectSchemaVersion {
+		opts = append(opts, WithSchemaVersionDetection())
+	}
+
+	if includeSARIF {
+		opts = append(opts, WithSARIF(documentURI))
+	}
+
+	return opts
+}
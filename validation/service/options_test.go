@@ -0,0 +1,218 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// testSchema requires records to have a name.
+const testSchema = `{"type": "object", "required": ["name"]}`
+
+func TestWithSchemaFS(t *testing.T) {
+	validator, err := NewValidationService(
+		WithoutEmbeddedSchemas(),
+		WithSchemaFS(fstest.MapFS{"v9.9.9.json": {Data: []byte(testSchema)}}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	if got := validator.SchemaVersions(); !slices.Equal(got, []string{"v9.9.9"}) {
+		t.Errorf("got schema versions %v, want only the added version", got)
+	}
+
+	result, err := validator.ValidateDocument(t.Context(), []byte("schema_version: v9.9.9\n"))
+	if err != nil {
+		t.Fatalf("failed to validate document: %v", err)
+	}
+
+	if result.IsValid || result.SchemaVersion != "v9.9.9" {
+		t.Errorf("got result %+v, want an invalid record validated against v9.9.9", result)
+	}
+
+	if _, err := NewValidationService(WithoutEmbeddedSchemas()); err == nil {
+		t.Error("expected a service without schemas to be rejected")
+	}
+}
+
+func TestWithRules(t *testing.T) {
+	requireDescription := Rule{
+		Name: "description-required",
+		Check: func(_ context.Context, record map[string]any) []RuleViolation {
+			if _, ok := record["description"]; ok {
+				return nil
+			}
+
+			return []RuleViolation{{Path: "/name", Message: "records must have a description"}}
+		},
+	}
+
+	validator, err := NewValidationService(
+		WithSchemaFS(fstest.MapFS{"v9.9.9.json": {Data: []byte(testSchema)}}),
+		WithRules(requireDescription),
+	)
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	result, err := validator.ValidateDocument(t.Context(), []byte("schema_version: v9.9.9\nname: example\n"))
+	if err != nil {
+		t.Fatalf("failed to validate document: %v", err)
+	}
+
+	if result.IsValid || len(result.Issues) != 1 {
+		t.Fatalf("got result %+v, want a single rule violation", result)
+	}
+
+	issue := result.Issues[0]
+	if issue.GetKeyword() != requireDescription.Name || issue.GetLine() != 2 || issue.GetColumn() != 7 {
+		t.Errorf("got issue %v, want the rule violation at line 2, column 7", issue)
+	}
+
+	result, err = validator.ValidateDocument(t.Context(), []byte("schema_version: v9.9.9\nname: example\ndescription: example\n"))
+	if err != nil {
+		t.Fatalf("failed to validate document: %v", err)
+	}
+
+	if !result.IsValid {
+		t.Errorf("got errors %v, want a valid record", result.Errors)
+	}
+}
+
+// roundTripFunc serves HTTP requests with a function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	var fetched []string
+
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		fetched = append(fetched, req.URL.String())
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(testSchema)),
+			Request:    req,
+		}, nil
+	})}
+
+	validator, err := NewValidationService(WithHTTPClient(client))
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	const schemaURL = "https://schemas.example.com/record"
+
+	result, err := validator.ValidateDocument(t.Context(), []byte(`{"name": "example"}`), WithSchemaURL(schemaURL))
+	if err != nil {
+		t.Fatalf("failed to validate document: %v", err)
+	}
+
+	if !result.IsValid {
+		t.Errorf("got errors %v, want a valid record", result.Errors)
+	}
+
+	if !slices.Equal(fetched, []string{schemaURL}) {
+		t.Errorf("got fetched URLs %v, want the schema URL through the HTTP client", fetched)
+	}
+}
+
+func TestWithMaxSchemaSize(t *testing.T) {
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(testSchema)),
+			Request:    req,
+		}, nil
+	})}
+
+	tests := []struct {
+		name      string
+		size      int64
+		wantValid bool
+	}{
+		{name: "schema of the maximum size", size: int64(len(testSchema)), wantValid: true},
+		{name: "larger schema", size: int64(len(testSchema)) - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewValidationService(WithHTTPClient(client), WithMaxSchemaSize(tt.size))
+			if err != nil {
+				t.Fatalf("failed to create validation service: %v", err)
+			}
+
+			_, err = validator.ValidateDocument(t.Context(), []byte(`{"name": "example"}`), WithSchemaURL("https://schemas.example.com/record"))
+
+			var schemaErr *InvalidSchemaError
+			if tt.wantValid && err != nil {
+				t.Errorf("failed to validate document: %v", err)
+			} else if !tt.wantValid && (!errors.As(err, &schemaErr) || !strings.Contains(err.Error(), "exceeds the maximum size")) {
+				t.Errorf("got error %v, want an InvalidSchemaError for the size", err)
+			}
+		})
+	}
+
+	if _, err := NewValidationService(WithMaxSchemaSize(0)); err == nil {
+		t.Error("expected a maximum schema size of 0 to be rejected")
+	}
+}
+
+func TestWithSchemaCacheTTL(t *testing.T) {
+	tests := []struct {
+		name        string
+		ttl         time.Duration
+		wantFetches int
+	}{
+		{name: "cached schema", ttl: time.Minute, wantFetches: 1},
+		{name: "disabled cache", ttl: 0, wantFetches: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fetches int
+
+			client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				fetches++
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(testSchema)),
+					Request:    req,
+				}, nil
+			})}
+
+			validator, err := NewValidationService(WithHTTPClient(client), WithSchemaCacheTTL(tt.ttl))
+			if err != nil {
+				t.Fatalf("failed to create validation service: %v", err)
+			}
+
+			for range 2 {
+				if _, err := validator.ValidateDocument(t.Context(), []byte(`{"name": "example"}`), WithSchemaURL("https://schemas.example.com/record")); err != nil {
+					t.Fatalf("failed to validate document: %v", err)
+				}
+			}
+
+			if fetches != tt.wantFetches {
+				t.Errorf("got %d fetches, want %d", fetches, tt.wantFetches)
+			}
+		})
+	}
+
+	if _, err := NewValidationService(WithSchemaCacheTTL(-time.Minute)); err == nil {
+		t.Error("expected a negative schema cache TTL to be rejected")
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+)
+
+// Rule is a check that records must pass on top of their schema, eg. a policy of a directory.
+// Records that violate a rule are invalid.
+type Rule struct {
+	// Name identifies the rule. It is the keyword of the issues of the rule.
+	Name string
+
+	// Check returns the violations of the rule by a record, decoded from JSON. It is only called
+	// for records that are JSON objects, and must be safe for concurrent use.
+	Check func(ctx context.Context, record map[string]any) []RuleViolation
+}
+
+// RuleViolation is a violation of a rule by a record.
+type RuleViolation struct {
+	// Path is the JSON pointer to the offending value, eg. /skills/0. It is empty for the whole record.
+	Path string
+
+	// Message describes the violation.
+	Message string
+}
+
+// checkRules adds the violations of the rules by a document to result. Issues are positioned using
+// the parsed document, if any.
+func (v ValidationService) checkRules(ctx context.Context, document any, parsed *parsedDocument, result *ValidationResult) {
+	record, ok := document.(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, rule := range v.rules {
+		for _, violation := range rule.Check(ctx, record) {
+			result.Errors = append(result.Errors, fmt.Sprintf("Rule %s: %s", rule.Name, violation.Message))
+			result.Issues = append(result.Issues, parsed.ruleIssue(rule.Name, violation))
+		}
+	}
+}
+
+// ruleIssue converts a rule violation into an issue positioned in the document.
+// Issues of a nil document carry no position.
+func (d *parsedDocument) ruleIssue(name string, violation RuleViolation) *validationv1.ValidationIssue {
+	issue := &validationv1.ValidationIssue{
+		Message: violation.Message,
+		Path:    violation.Path,
+		Keyword: name,
+	}
+
+	if d != nil {
+		pos := d.values[violation.Path]
+		issue.Line = uint32(pos.line)
+		issue.Column = uint32(pos.column)
+	}
+
+	return issue
+}
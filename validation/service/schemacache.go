@@ -0,0 +1,83 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// DefaultSchemaCacheTTL is the default time a schema fetched from a schema URL is reused.
+const DefaultSchemaCacheTTL = 5 * time.Minute
+
+// schemaCacheSize is the maximum number of schema URLs cached at once.
+const schemaCacheSize = 64
+
+type cachedSchema struct {
+	schema  *gojsonschema.Schema
+	expires time.Time
+}
+
+// schemaCache holds the compiled schemas of schema URLs used within the last ttl.
+// Nothing is cached when ttl is zero.
+type schemaCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	schemas map[string]cachedSchema
+}
+
+func newSchemaCache(ttl time.Duration) *schemaCache {
+	return &schemaCache{
+		ttl:     ttl,
+		schemas: make(map[string]cachedSchema),
+	}
+}
+
+func (c *schemaCache) get(url string) (*gojsonschema.Schema, bool) {
+	if c.ttl == 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.schemas[url]
+	if !ok || time.Now().After(cached.expires) {
+		return nil, false
+	}
+
+	return cached.schema, true
+}
+
+func (c *schemaCache) add(url string, schema *gojsonschema.Schema) {
+	if c.ttl == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	if len(c.schemas) >= schemaCacheSize {
+		for cachedURL, cached := range c.schemas {
+			if now.After(cached.expires) {
+				delete(c.schemas, cachedURL)
+			}
+		}
+	}
+
+	// Schemas are fetched again once the cache is full of live entries.
+	if len(c.schemas) >= schemaCacheSize {
+		return
+	}
+
+	c.schemas[url] = cachedSchema{
+		schema:  schema,
+		expires: now.Add(c.ttl),
+	}
+}
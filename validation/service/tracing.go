@@ -0,0 +1,28 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/agntcy/oasf-sdk/validation/service"
+
+// tracer returns the tracer of the global tracer provider. It is looked up on each use, as
+// tracers obtained earlier keep using the provider that was global at the time.
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// endSpan ends a span, recording err if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
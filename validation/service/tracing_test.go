@@ -0,0 +1,111 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSchemaURLFetchesAreTraced(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	schema, err := embeddedSchemas.ReadFile("schemas/v0.6.0.json")
+	if err != nil {
+		t.Fatalf("failed to read schema: %v", err)
+	}
+
+	schemaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(schema)
+	}))
+	t.Cleanup(schemaServer.Close)
+
+	validator, err := NewValidationService(WithSchemaCacheTTL(time.Minute))
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	ctx, parent := otel.Tracer("test").Start(t.Context(), "test")
+
+	// The second validation uses the cached schema.
+	for range 2 {
+		_, err := validator.ValidateRecordDocument(ctx, &validationv1.ValidateRecordDocumentRequest{
+			Document:  []byte(`{"name": "test"}`),
+			SchemaUrl: schemaServer.URL,
+		})
+		if err != nil {
+			t.Fatalf("failed to validate document: %v", err)
+		}
+	}
+
+	parent.End()
+
+	spans := map[string][]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		if span.SpanContext.TraceID() == parent.SpanContext().TraceID() {
+			spans[span.Name] = append(spans[span.Name], span)
+		}
+	}
+
+	if got := len(spans["validation.validate"]); got != 2 {
+		t.Errorf("expected 2 validation spans, got %d", got)
+	}
+
+	lookups := spans["validation.schemaFromURL"]
+	if len(lookups) != 2 {
+		t.Fatalf("expected 2 schema URL spans, got %d", len(lookups))
+	}
+
+	for i, cacheHit := range []bool{false, true} {
+		if !hasAttribute(lookups[i], attribute.Bool("oasf.schema_cache_hit", cacheHit)) {
+			t.Errorf("expected schema URL span %d to have cache hit %t, got %v", i, cacheHit, lookups[i].Attributes)
+		}
+	}
+
+	compilations := spans["validation.compileSchema"]
+	if len(compilations) != 1 || !hasAttribute(compilations[0], attribute.String("url.full", schemaServer.URL)) {
+		t.Errorf("expected a single compilation of the fetched schema, got %v", compilations)
+	}
+
+	var fetches int
+	for _, named := range spans {
+		for _, span := range named {
+			if span.SpanKind == trace.SpanKindClient {
+				fetches++
+			}
+		}
+	}
+
+	if fetches != 1 {
+		t.Errorf("expected a single HTTP fetch span, got %d", fetches)
+	}
+}
+
+func hasAttribute(span tracetest.SpanStub, want attribute.KeyValue) bool {
+	for _, attr := range span.Attributes {
+		if attr == want {
+			return true
+		}
+	}
+
+	return false
+}
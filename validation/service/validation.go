@@ -4,80 +4,282 @@
 package service
 
 import (
+	"context"
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"net/http"
-	"path/filepath"
+	"net/url"
 	"strings"
 	"time"
 
 	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
 	objectsv3 "buf.build/gen/go/agntcy/oasf/protocolbuffers/go/objects/v3"
+	"github.com/agntcy/oasf-sdk/validation/report"
 	"github.com/xeipuuv/gojsonschema"
-	"google.golang.org/protobuf/encoding/protojson"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:embed schemas/*.json
 var embeddedSchemas embed.FS
 
+// schemaFetchTimeout bounds the fetch of a schema URL, unless the request has an earlier deadline.
+const schemaFetchTimeout = 30 * time.Second
+
+// DefaultMaxSchemaSize is the default maximum size in bytes of the schema at a schema URL.
+const DefaultMaxSchemaSize = 10 << 20
+
+// ValidationService validates records against the OASF schemas. It is safe for concurrent use.
 type ValidationService struct {
-	schemas    map[string]*gojsonschema.Schema
-	httpClient *http.Client
+	schemas         map[string]*gojsonschema.Schema
+	schemaCache     *schemaCache
+	schemaCacheTTL  time.Duration
+	httpClient      *http.Client
+	maxSchemaSize   int64
+	versionFallback VersionFallback
+	rules           []Rule
+
+	// schemaSources hold the schemas added to, or replacing, the embedded schemas.
+	schemaSources    []fs.FS
+	embeddedDisabled bool
+}
+
+// ValidationResult is the outcome of validating a record.
+type ValidationResult struct {
+	// IsValid reports whether the record is valid.
+	IsValid bool
+
+	// Errors describes every validation error.
+	Errors []string
+
+	// Issues holds the structured form of Errors.
+	Issues []*validationv1.ValidationIssue
+
+	// SchemaVersion is the embedded schema version the record was validated against.
+	// It is empty when the record was validated against a schema URL.
+	SchemaVersion string
+
+	// Warnings lists notes that do not affect validity, eg. how the schema version was chosen.
+	Warnings []string
+
+	// SARIF is the JSON encoded SARIF 2.1.0 log of the issues, if requested.
+	SARIF string
 }
 
-func NewValidationService() (*ValidationService, error) {
-	schemas, err := loadEmbeddedSchemas()
+// Report converts the result into a report result for the record at file, which can be empty.
+// Results of a batch of records can then be written with the writers of the report package.
+func (r *ValidationResult) Report(file string) *report.Result {
+	return &report.Result{
+		File:          file,
+		Valid:         r.IsValid,
+		SchemaVersion: r.SchemaVersion,
+		Warnings:      r.Warnings,
+		Issues:        report.NewIssues(r.Issues),
+	}
+}
+
+// addSARIF sets the SARIF log of the result. Issues are located in the document at uri, if any.
+func (r *ValidationResult) addSARIF(uri string) error {
+	data, err := report.SARIF([]*report.Result{r.Report(uri)})
 	if err != nil {
-		return nil, fmt.Errorf("failed to load embedded schemas: %w", err)
+		return err
 	}
 
-	return &ValidationService{
-		schemas: schemas,
+	r.SARIF = string(data)
+
+	return nil
+}
+
+// NewValidationService creates a validation service. By default, records are validated against
+// the embedded schemas, and schema URLs are fetched with an HTTP client that traces its requests.
+func NewValidationService(opts ...Option) (*ValidationService, error) {
+	service := &ValidationService{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
-	}, nil
+		maxSchemaSize:   DefaultMaxSchemaSize,
+		schemaCacheTTL:  DefaultSchemaCacheTTL,
+		versionFallback: VersionFallbackNone,
+	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	if _, err := ParseVersionFallback(string(service.versionFallback)); err != nil {
+		return nil, err
+	}
+
+	if service.maxSchemaSize < 1 {
+		return nil, fmt.Errorf("maximum schema size %d is not positive", service.maxSchemaSize)
+	}
+
+	if service.schemaCacheTTL < 0 {
+		return nil, fmt.Errorf("schema cache TTL %s is negative", service.schemaCacheTTL)
+	}
+
+	service.schemaCache = newSchemaCache(service.schemaCacheTTL)
+
+	schemas, err := service.loadSchemas()
+	if err != nil {
+		return nil, err
+	}
+
+	service.schemas = schemas
+
+	return service, nil
 }
 
-func (v ValidationService) ValidateRecord(req *validationv1.ValidateRecordRequest) (bool, []string, error) {
-	if req.Record == nil {
-		return false, []string{"record cannot be nil"}, nil
+// Validate validates a record against the schema of its version, or the schema set by the options.
+func (v ValidationService) Validate(ctx context.Context, record *objectsv3.Record, opts ...ValidateOption) (*ValidationResult, error) {
+	o := newValidateOptions(opts)
+
+	result, err := v.validateRecord(ctx, record, o)
+	observeValidation(result, o.schemaURL, err)
+
+	if err != nil {
+		return nil, err
 	}
 
-	if req.SchemaUrl != "" {
-		schemaErrors, err := v.validateWithSchemaURL(req.Record, req.SchemaUrl)
-		if err != nil {
-			return false, nil, fmt.Errorf("schema URL validation failed: %w", err)
+	if o.sarif {
+		if err := result.addSARIF(o.documentURI); err != nil {
+			return nil, err
 		}
+	}
 
-		return len(schemaErrors) == 0, schemaErrors, nil
+	return result, nil
+}
+
+// ValidateRecord validates the record of a request. It is the counterpart of Validate for the requests of the gRPC service.
+func (v ValidationService) ValidateRecord(ctx context.Context, req *validationv1.ValidateRecordRequest) (*ValidationResult, error) {
+	return v.Validate(ctx, req.GetRecord(), requestOptions(req.GetSchemaUrl(), req.GetDetectSchemaVersion(), req.GetIncludeSarif(), "")...)
+}
+
+func (v ValidationService) validateRecord(ctx context.Context, record *objectsv3.Record, o validateOptions) (*ValidationResult, error) {
+	if record == nil {
+		return &ValidationResult{
+			Errors: []string{"record cannot be nil"},
+			Issues: []*validationv1.ValidationIssue{{Message: "record cannot be nil"}},
+		}, nil
 	}
 
-	schema, schemaExists := v.schemas[req.Record.SchemaVersion]
-	if !schemaExists {
-		var availableVersions []string
-		for version := range v.schemas {
-			availableVersions = append(availableVersions, version)
+	recordData, err := messageToDocument(record)
+	if err != nil {
+		return nil, fmt.Errorf("JSON schema validation failed: %w", err)
+	}
+
+	return v.validate(ctx, recordData, nil, o.schemaURL, o.detectSchemaVersion)
+}
+
+// validate checks a document against the schema at schemaURL, or against the embedded schema
+// matching its version. Issues are positioned using the parsed document, if any.
+func (v ValidationService) validate(ctx context.Context, document any, parsed *parsedDocument, schemaURL string, detect bool) (_ *ValidationResult, err error) {
+	ctx, span := tracer().Start(ctx, "validation.validate")
+	defer func() { endSpan(span, err) }()
+
+	// Validation itself cannot be interrupted, so it is not started for requests that are already done.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &ValidationResult{}
+
+	var schema *gojsonschema.Schema
+	if schemaURL != "" {
+		schema, err = v.schemaFromURL(ctx, schemaURL)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		version, warnings, err := v.resolveSchemaVersion(document, detect)
+		if err != nil {
+			return nil, err
 		}
 
-		return false, nil, fmt.Errorf("no schema found for version %s. Available versions: %v", req.Record.SchemaVersion, availableVersions)
+		schema = v.schemas[version]
+		result.SchemaVersion = version
+		result.Warnings = warnings
+
+		span.SetAttributes(attribute.String("oasf.schema_version", version))
 	}
 
-	schemaErrors, err := v.validateWithJSONSchema(req.Record, schema)
+	resultErrors, err := validateDocument(document, schema)
 	if err != nil {
-		return false, nil, fmt.Errorf("JSON schema validation failed: %w", err)
+		return nil, fmt.Errorf("JSON schema validation failed: %w", err)
+	}
+
+	for _, resultErr := range resultErrors {
+		result.Errors = append(result.Errors, fmt.Sprintf("JSON Schema: %s", resultErr.String()))
+		result.Issues = append(result.Issues, parsed.issue(resultErr))
+	}
+
+	v.checkRules(ctx, document, parsed, result)
+
+	result.IsValid = len(result.Errors) == 0
+
+	span.SetAttributes(
+		attribute.Bool("oasf.valid", result.IsValid),
+		attribute.Int("oasf.issues", len(result.Errors)),
+	)
+
+	return result, nil
+}
+
+func (v ValidationService) embeddedSchema(schemaVersion string) (*gojsonschema.Schema, error) {
+	schema, schemaExists := v.schemas[schemaVersion]
+	if !schemaExists {
+		return nil, &SchemaVersionError{
+			Version:           schemaVersion,
+			AvailableVersions: v.SchemaVersions(),
+		}
 	}
 
-	return len(schemaErrors) == 0, schemaErrors, nil
+	return schema, nil
 }
 
-func loadEmbeddedSchemas() (map[string]*gojsonschema.Schema, error) {
+// loadSchemas compiles the embedded schemas, unless disabled, and then the schemas of the schema sources,
+// which replace the schemas of the same version.
+func (v ValidationService) loadSchemas() (_ map[string]*gojsonschema.Schema, err error) {
+	ctx, span := tracer().Start(context.Background(), "validation.loadSchemas")
+	defer func() { endSpan(span, err) }()
+
 	schemas := make(map[string]*gojsonschema.Schema)
 
-	entries, err := embeddedSchemas.ReadDir("schemas")
+	if !v.embeddedDisabled {
+		embedded, err := fs.Sub(embeddedSchemas, "schemas")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read embedded schemas directory: %w", err)
+		}
+
+		if err := loadSchemaFS(ctx, embedded, schemas); err != nil {
+			return nil, fmt.Errorf("failed to load embedded schemas: %w", err)
+		}
+	}
+
+	for _, source := range v.schemaSources {
+		if err := loadSchemaFS(ctx, source, schemas); err != nil {
+			return nil, fmt.Errorf("failed to load schemas: %w", err)
+		}
+	}
+
+	if len(schemas) == 0 {
+		return nil, errors.New("no valid JSON schema files found")
+	}
+
+	return schemas, nil
+}
+
+// loadSchemaFS compiles the schema files at the root of fsys, named after their version, eg. v0.6.0.json,
+// into schemas.
+func loadSchemaFS(ctx context.Context, fsys fs.FS, schemas map[string]*gojsonschema.Schema) error {
+	entries, err := fs.ReadDir(fsys, ".")
 	if err != nil {
-		return nil, fmt.Errorf("failed to read embedded schemas directory: %w", err)
+		return fmt.Errorf("failed to read schemas directory: %w", err)
 	}
 
 	for _, entry := range entries {
@@ -88,88 +290,124 @@ func loadEmbeddedSchemas() (map[string]*gojsonschema.Schema, error) {
 		filename := entry.Name()
 		version := strings.TrimSuffix(filename, ".json")
 
-		schemaPath := filepath.Join("schemas", filename)
-		schemaData, err := embeddedSchemas.ReadFile(schemaPath)
+		schemaData, err := fs.ReadFile(fsys, filename)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read embedded schema file %s: %w", filename, err)
+			return fmt.Errorf("failed to read schema file %s: %w", filename, err)
 		}
 
-		schemaLoader := gojsonschema.NewStringLoader(string(schemaData))
-		schema, err := gojsonschema.NewSchema(schemaLoader)
+		schema, err := compileSchema(ctx, schemaData, attribute.String("oasf.schema_version", version))
 		if err != nil {
-			return nil, fmt.Errorf("failed to compile embedded schema %s: %w", filename, err)
+			return fmt.Errorf("failed to compile schema %s: %w", filename, err)
 		}
 
 		schemas[version] = schema
 	}
 
-	if len(schemas) == 0 {
-		return nil, fmt.Errorf("no valid JSON schema files found in embedded schemas")
+	return nil
+}
+
+func validateDocument(document any, schema *gojsonschema.Schema) ([]gojsonschema.ResultError, error) {
+	documentLoader := gojsonschema.NewGoLoader(document)
+	result, err := schema.Validate(documentLoader)
+	if err != nil {
+		return nil, fmt.Errorf("schema validation error: %w", err)
 	}
 
-	return schemas, nil
+	if result.Valid() {
+		return nil, nil
+	}
+
+	return result.Errors(), nil
+}
+
+// compileSchema compiles a JSON schema, tracing the compilation with the given attributes.
+func compileSchema(ctx context.Context, data []byte, attrs ...attribute.KeyValue) (_ *gojsonschema.Schema, err error) {
+	_, span := tracer().Start(ctx, "validation.compileSchema", trace.WithAttributes(attrs...))
+	defer func() { endSpan(span, err) }()
+
+	return gojsonschema.NewSchema(gojsonschema.NewBytesLoader(data))
 }
 
-func (v ValidationService) validateWithJSONSchema(record *objectsv3.Record, schema *gojsonschema.Schema) ([]string, error) {
-	marshaler := &protojson.MarshalOptions{
-		UseProtoNames: true,
+// schemaFromURL returns the schema at schemaURL, fetching it unless it is cached.
+func (v ValidationService) schemaFromURL(ctx context.Context, schemaURL string) (_ *gojsonschema.Schema, err error) {
+	ctx, span := tracer().Start(ctx, "validation.schemaFromURL", trace.WithAttributes(attribute.String("url.full", schemaURL)))
+	defer func() { endSpan(span, err) }()
+
+	if schema, ok := v.schemaCache.get(schemaURL); ok {
+		schemaURLRequests.WithLabelValues(schemaURLCacheHit).Inc()
+		span.SetAttributes(attribute.Bool("oasf.schema_cache_hit", true))
+
+		return schema, nil
 	}
-	jsonBytes, err := marshaler.Marshal(record)
+
+	span.SetAttributes(attribute.Bool("oasf.schema_cache_hit", false))
+
+	schema, err := v.fetchSchema(ctx, schemaURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal record to JSON: %w", err)
-	}
+		schemaURLRequests.WithLabelValues(schemaURLFailed).Inc()
 
-	var recordData map[string]interface{}
-	if err := json.Unmarshal(jsonBytes, &recordData); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+		return nil, err
 	}
 
-	// Convert size fields from strings to integers for validation
-	if locators, ok := recordData["locators"].([]any); ok {
-		for _, locatorIntf := range locators {
-			if locator, ok := locatorIntf.(map[string]any); ok {
-				if sizeStr, ok := locator["size"].(string); ok {
-					// Try to convert string to integer
-					var size int64
-					if _, err := fmt.Sscanf(sizeStr, "%d", &size); err == nil {
-						locator["size"] = size
-					}
-				}
-			}
-		}
+	schemaURLRequests.WithLabelValues(schemaURLFetched).Inc()
+	v.schemaCache.add(schemaURL, schema)
+
+	return schema, nil
+}
+
+func (v ValidationService) fetchSchema(ctx context.Context, schemaURL string) (*gojsonschema.Schema, error) {
+	if err := checkSchemaURL(schemaURL); err != nil {
+		return nil, &InvalidSchemaURLError{URL: schemaURL, Err: err}
 	}
 
-	documentLoader := gojsonschema.NewGoLoader(recordData)
-	result, err := schema.Validate(documentLoader)
+	// The fetch is canceled along with the request, and bounded for callers without a deadline.
+	ctx, cancel := context.WithTimeout(ctx, schemaFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, schemaURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("schema validation error: %w", err)
+		return nil, &InvalidSchemaURLError{URL: schemaURL, Err: err}
 	}
 
-	var errors []string
-	if !result.Valid() {
-		for _, desc := range result.Errors() {
-			errors = append(errors, fmt.Sprintf("JSON Schema: %s", desc.String()))
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, &SchemaURLError{
+			URL: schemaURL,
+			Err: fmt.Errorf("failed to fetch schema from URL %s: %w", schemaURL, err),
 		}
 	}
+	defer resp.Body.Close()
 
-	return errors, nil
-}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &SchemaURLError{
+			URL:        schemaURL,
+			StatusCode: resp.StatusCode,
+			Err:        fmt.Errorf("failed to fetch schema from URL %s: HTTP %d", schemaURL, resp.StatusCode),
+		}
+	}
 
-func (v ValidationService) validateWithSchemaURL(record *objectsv3.Record, schemaURL string) ([]string, error) {
-	resp, err := v.httpClient.Get(schemaURL)
+	// One more byte than the maximum is read, to tell schemas of the maximum size from larger ones.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, v.maxSchemaSize+1))
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch schema from URL %s: %w", schemaURL, err)
+		return nil, &SchemaURLError{
+			URL: schemaURL,
+			Err: fmt.Errorf("failed to read schema from URL %s: %w", schemaURL, err),
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch schema from URL %s: HTTP %d", schemaURL, resp.StatusCode)
+	if int64(len(data)) > v.maxSchemaSize {
+		return nil, &InvalidSchemaError{
+			URL: schemaURL,
+			Err: fmt.Errorf("schema from URL %s exceeds the maximum size of %d bytes", schemaURL, v.maxSchemaSize),
+		}
 	}
 
-	decoder := json.NewDecoder(resp.Body)
 	var schemaData interface{}
-	if err := decoder.Decode(&schemaData); err != nil {
-		return nil, fmt.Errorf("failed to decode schema JSON from URL %s: %w", schemaURL, err)
+	if err := json.Unmarshal(data, &schemaData); err != nil {
+		return nil, &InvalidSchemaError{
+			URL: schemaURL,
+			Err: fmt.Errorf("failed to decode schema JSON from URL %s: %w", schemaURL, err),
+		}
 	}
 
 	schemaBytes, err := json.Marshal(schemaData)
@@ -177,11 +415,75 @@ func (v ValidationService) validateWithSchemaURL(record *objectsv3.Record, schem
 		return nil, fmt.Errorf("failed to marshal schema from URL %s: %w", schemaURL, err)
 	}
 
-	schemaLoader := gojsonschema.NewStringLoader(string(schemaBytes))
-	schema, err := gojsonschema.NewSchema(schemaLoader)
+	schema, err := compileSchema(ctx, schemaBytes, attribute.String("url.full", schemaURL))
 	if err != nil {
-		return nil, fmt.Errorf("failed to compile schema from URL %s: %w", schemaURL, err)
+		return nil, &InvalidSchemaError{
+			URL: schemaURL,
+			Err: fmt.Errorf("failed to compile schema from URL %s: %w", schemaURL, err),
+		}
+	}
+
+	return schema, nil
+}
+
+// checkSchemaURL checks that a schema URL is an absolute HTTP or HTTPS URL.
+func checkSchemaURL(schemaURL string) error {
+	parsed, err := url.Parse(schemaURL)
+	if err != nil {
+		return err
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q, expected http or https", parsed.Scheme)
+	}
+
+	if parsed.Host == "" {
+		return errors.New("missing host")
+	}
+
+	return nil
+}
+
+// schemaKeywords maps gojsonschema error types to the JSON schema keywords that produce them.
+var schemaKeywords = map[string]string{
+	"false":                           "false",
+	"required":                        "required",
+	"invalid_type":                    "type",
+	"number_any_of":                   "anyOf",
+	"number_one_of":                   "oneOf",
+	"number_all_of":                   "allOf",
+	"number_not":                      "not",
+	"missing_dependency":              "dependencies",
+	"const":                           "const",
+	"enum":                            "enum",
+	"array_no_additional_items":       "additionalItems",
+	"array_min_items":                 "minItems",
+	"array_max_items":                 "maxItems",
+	"unique":                          "uniqueItems",
+	"contains":                        "contains",
+	"array_min_properties":            "minProperties",
+	"array_max_properties":            "maxProperties",
+	"additional_property_not_allowed": "additionalProperties",
+	"invalid_property_pattern":        "patternProperties",
+	"invalid_property_name":           "propertyNames",
+	"string_gte":                      "minLength",
+	"string_lte":                      "maxLength",
+	"pattern":                         "pattern",
+	"format":                          "format",
+	"multiple_of":                     "multipleOf",
+	"number_gte":                      "minimum",
+	"number_gt":                       "exclusiveMinimum",
+	"number_lte":                      "maximum",
+	"number_lt":                       "exclusiveMaximum",
+	"condition_then":                  "then",
+	"condition_else":                  "else",
+}
+
+// schemaKeyword returns the JSON schema keyword that caused the given error.
+func schemaKeyword(resultErr gojsonschema.ResultError) string {
+	if keyword, ok := schemaKeywords[resultErr.Type()]; ok {
+		return keyword
 	}
 
-	return v.validateWithJSONSchema(record, schema)
+	return resultErr.Type()
 }
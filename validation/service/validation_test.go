@@ -0,0 +1,71 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	validationv1 "buf.build/gen/go/agntcy/oasf-sdk/protocolbuffers/go/validation/v1"
+)
+
+func TestSchemaURLFetchIsCanceledWithRequest(t *testing.T) {
+	canceled := make(chan struct{})
+
+	// The schema server never answers, until the fetch is canceled.
+	schemaServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		close(canceled)
+	}))
+	t.Cleanup(schemaServer.Close)
+
+	validator, err := NewValidationService()
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err = validator.ValidateRecordDocument(ctx, &validationv1.ValidateRecordDocumentRequest{
+		Document:  []byte(`{"name": "test"}`),
+		SchemaUrl: schemaServer.URL,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the validation to stop at the deadline, took %v", elapsed)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Error("expected the schema fetch to be canceled")
+	}
+}
+
+func TestValidateRecordIsNotStartedOnceCanceled(t *testing.T) {
+	validator, err := NewValidationService()
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err = validator.ValidateRecordDocument(ctx, &validationv1.ValidateRecordDocumentRequest{
+		Document: []byte(`{"name": "test", "schema_version": "v0.6.0"}`),
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a canceled error, got %v", err)
+	}
+}
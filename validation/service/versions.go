@@ -0,0 +1,311 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VersionFallback decides which embedded schema is used for a schema version
+// that is not embedded in the service.
+type VersionFallback string
+
+const (
+	// VersionFallbackNone rejects unknown schema versions.
+	VersionFallbackNone VersionFallback = "none"
+
+	// VersionFallbackPatch uses the closest embedded version with the same major and minor version.
+	VersionFallbackPatch VersionFallback = "patch"
+
+	// VersionFallbackMinor uses the closest embedded version with the same major version.
+	VersionFallbackMinor VersionFallback = "minor"
+)
+
+const (
+	// Prefixes of the names used by v0.5 records, which were dropped in v0.6.
+	legacyFeaturePrefix = "schema.oasf.agntcy.org/features/"
+	legacySkillPrefix   = "schema.oasf.agntcy.org/skills/"
+
+	// runtimeExtensionPrefix is the prefix of the names of the runtime extensions of v0.6 records.
+	runtimeExtensionPrefix = "runtime/"
+)
+
+// ParseVersionFallback converts a policy name into a VersionFallback.
+func ParseVersionFallback(name string) (VersionFallback, error) {
+	switch policy := VersionFallback(strings.ToLower(name)); policy {
+	case "":
+		return VersionFallbackNone, nil
+	case VersionFallbackNone, VersionFallbackPatch, VersionFallbackMinor:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown schema version fallback %q, expected one of: %s, %s, %s", name, VersionFallbackNone, VersionFallbackPatch, VersionFallbackMinor)
+	}
+}
+
+// resolveSchemaVersion returns the embedded schema version to validate a document against,
+// along with warnings describing how it was chosen.
+func (v ValidationService) resolveSchemaVersion(document any, detect bool) (string, []string, error) {
+	requested := documentSchemaVersion(document)
+	if _, ok := v.schemas[requested]; ok {
+		return requested, nil, nil
+	}
+
+	if requested != "" {
+		if version, ok := v.fallbackSchemaVersion(requested); ok {
+			return version, []string{
+				fmt.Sprintf("schema version %s is not available, validated against the closest version %s", requested, version),
+			}, nil
+		}
+	}
+
+	if !detect {
+		_, err := v.embeddedSchema(requested)
+
+		return "", nil, err
+	}
+
+	version, err := v.detectSchemaVersion(document)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if requested == "" {
+		return version, []string{
+			fmt.Sprintf("schema version is not set, validated against detected version %s", version),
+		}, nil
+	}
+
+	return version, []string{
+		fmt.Sprintf("schema version %s is not available, validated against detected version %s", requested, version),
+	}, nil
+}
+
+// fallbackSchemaVersion finds the closest embedded version allowed by the fallback policy.
+// Older versions are preferred over newer ones.
+func (v ValidationService) fallbackSchemaVersion(requested string) (string, bool) {
+	if v.versionFallback == VersionFallbackNone {
+		return "", false
+	}
+
+	target, ok := parseSchemaVersion(requested)
+	if !ok {
+		return "", false
+	}
+
+	var older, newer []semanticVersion
+	for version := range v.schemas {
+		candidate, ok := parseSchemaVersion(version)
+		if !ok || candidate.major != target.major {
+			continue
+		}
+
+		if v.versionFallback == VersionFallbackPatch && candidate.minor != target.minor {
+			continue
+		}
+
+		if candidate.less(target) {
+			older = append(older, candidate)
+		} else {
+			newer = append(newer, candidate)
+		}
+	}
+
+	sortVersions(older)
+	sortVersions(newer)
+
+	switch {
+	case len(older) > 0:
+		return older[len(older)-1].name, true
+	case len(newer) > 0:
+		return newer[0].name, true
+	default:
+		return "", false
+	}
+}
+
+// detectSchemaVersion infers the schema version of a document from its structure, as the latest
+// available version of the schema line it belongs to. Documents whose structure is ambiguous, or
+// whose line is not available, are detected as the available version they have the fewest schema
+// errors against.
+func (v ValidationService) detectSchemaVersion(document any) (string, error) {
+	if version, ok := v.structuralSchemaVersion(document); ok {
+		return version, nil
+	}
+
+	return v.closestSchemaVersion(document)
+}
+
+// structuralSchemaVersion infers the schema version of a document from the fields specific to
+// a schema line: v0.5 records name their features and skills after schema.oasf.agntcy.org,
+// while v0.6 records have domains, a schema URL and runtime/* extensions.
+func (v ValidationService) structuralSchemaVersion(document any) (string, bool) {
+	root, _ := document.(map[string]any)
+
+	var legacy, current int
+
+	for _, field := range []string{"domains", "schema_url"} {
+		if _, ok := root[field]; ok {
+			current++
+		}
+	}
+
+	for _, name := range itemNames(root["extensions"]) {
+		switch {
+		case strings.HasPrefix(name, legacyFeaturePrefix):
+			legacy++
+		case strings.HasPrefix(name, runtimeExtensionPrefix):
+			current++
+		}
+	}
+
+	for _, name := range itemNames(root["skills"]) {
+		if strings.HasPrefix(name, legacySkillPrefix) {
+			legacy++
+		} else {
+			current++
+		}
+	}
+
+	switch {
+	case legacy > current:
+		return v.latestSchemaVersion(0, 5)
+	case current > legacy:
+		return v.latestSchemaVersion(0, 6)
+	default:
+		return "", false
+	}
+}
+
+// latestSchemaVersion returns the latest available version of a schema line.
+func (v ValidationService) latestSchemaVersion(major, minor int) (string, bool) {
+	var latest string
+
+	for _, version := range v.SchemaVersions() {
+		if parsed, ok := parseSchemaVersion(version); ok && parsed.major == major && parsed.minor == minor {
+			latest = version
+		}
+	}
+
+	return latest, latest != ""
+}
+
+// closestSchemaVersion returns the available version a document has the fewest schema errors
+// against. Ties are resolved in favor of the latest version.
+func (v ValidationService) closestSchemaVersion(document any) (string, error) {
+	var closest string
+
+	fewestErrors := -1
+
+	for _, version := range v.SchemaVersions() {
+		resultErrors, err := validateDocument(document, v.schemas[version])
+		if err != nil {
+			return "", fmt.Errorf("failed to detect schema version: %w", err)
+		}
+
+		if fewestErrors < 0 || len(resultErrors) <= fewestErrors {
+			closest, fewestErrors = version, len(resultErrors)
+		}
+	}
+
+	return closest, nil
+}
+
+// SchemaVersions returns the schema versions records can be validated against, oldest first.
+func (v ValidationService) SchemaVersions() []string {
+	versions := make([]string, 0, len(v.schemas))
+	for version := range v.schemas {
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		left, leftOk := parseSchemaVersion(versions[i])
+		right, rightOk := parseSchemaVersion(versions[j])
+		if !leftOk || !rightOk {
+			return versions[i] < versions[j]
+		}
+
+		return left.less(right)
+	})
+
+	return versions
+}
+
+// documentSchemaVersion returns the schema_version declared by a document, if any.
+func documentSchemaVersion(document any) string {
+	root, ok := document.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	version, _ := root["schema_version"].(string)
+
+	return version
+}
+
+// itemNames returns the names of the objects of a list, eg. the extensions of a record.
+func itemNames(value any) []string {
+	items, _ := value.([]any)
+
+	var names []string
+	for _, item := range items {
+		if object, ok := item.(map[string]any); ok {
+			if name, ok := object["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
+type semanticVersion struct {
+	name                string
+	major, minor, patch int
+}
+
+// parseSchemaVersion parses versions in the form vMAJOR.MINOR.PATCH, with an optional "v" prefix.
+func parseSchemaVersion(version string) (semanticVersion, bool) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) != 3 {
+		return semanticVersion{}, false
+	}
+
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return semanticVersion{}, false
+		}
+
+		numbers[i] = number
+	}
+
+	return semanticVersion{
+		name:  version,
+		major: numbers[0],
+		minor: numbers[1],
+		patch: numbers[2],
+	}, true
+}
+
+func (s semanticVersion) less(other semanticVersion) bool {
+	if s.major != other.major {
+		return s.major < other.major
+	}
+
+	if s.minor != other.minor {
+		return s.minor < other.minor
+	}
+
+	return s.patch < other.patch
+}
+
+func sortVersions(versions []semanticVersion) {
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].less(versions[j])
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseVersionFallback(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    VersionFallback
+		wantErr bool
+	}{
+		{name: "", want: VersionFallbackNone},
+		{name: "none", want: VersionFallbackNone},
+		{name: "patch", want: VersionFallbackPatch},
+		{name: "Minor", want: VersionFallbackMinor},
+		{name: "major", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseVersionFallback(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("got error %v for %q, want error %v", err, tt.name, tt.wantErr)
+		}
+
+		if got != tt.want {
+			t.Errorf("got policy %q for %q, want %q", got, tt.name, tt.want)
+		}
+	}
+}
+
+func TestParseSchemaVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    semanticVersion
+		wantOk  bool
+	}{
+		{version: "v0.6.0", want: semanticVersion{name: "v0.6.0", minor: 6}, wantOk: true},
+		{version: "1.12.3", want: semanticVersion{name: "1.12.3", major: 1, minor: 12, patch: 3}, wantOk: true},
+		{version: "v0.6"},
+		{version: "v0.6.0.1"},
+		{version: "v0.6.x"},
+		{version: "v0.-1.0"},
+		{version: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseSchemaVersion(tt.version)
+		if ok != tt.wantOk || got != tt.want {
+			t.Errorf("got %+v, %v for %q, want %+v, %v", got, ok, tt.version, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestFallbackSchemaVersion(t *testing.T) {
+	schemas := map[string]bool{"v0.5.0": true, "v0.5.2": true, "v0.6.0": true, "v0.8.1": true, "v1.0.0": true}
+
+	tests := []struct {
+		name      string
+		policy    VersionFallback
+		requested string
+		want      string
+	}{
+		{name: "none", policy: VersionFallbackNone, requested: "v0.5.1"},
+		{name: "patch prefers older", policy: VersionFallbackPatch, requested: "v0.5.1", want: "v0.5.0"},
+		{name: "patch closest older", policy: VersionFallbackPatch, requested: "v0.5.9", want: "v0.5.2"},
+		{name: "patch newer", policy: VersionFallbackPatch, requested: "v0.8.0", want: "v0.8.1"},
+		{name: "patch other minor", policy: VersionFallbackPatch, requested: "v0.7.0"},
+		{name: "minor older", policy: VersionFallbackMinor, requested: "v0.7.0", want: "v0.6.0"},
+		{name: "invalid version", policy: VersionFallbackMinor, requested: "v1.0.0-rc"},
+		{name: "minor other major", policy: VersionFallbackMinor, requested: "v2.0.0"},
+		{name: "minor lowest", policy: VersionFallbackMinor, requested: "v0.4.0", want: "v0.5.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := newVersionsService(t, schemas, WithVersionFallback(tt.policy))
+
+			got, ok := validator.fallbackSchemaVersion(tt.requested)
+			if got != tt.want || ok != (tt.want != "") {
+				t.Errorf("got %q, %v, want %q", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+// newVersionsService returns a service with a schema accepting any document for every version.
+func newVersionsService(t *testing.T, versions map[string]bool, opts ...Option) *ValidationService {
+	t.Helper()
+
+	fsys := fstest.MapFS{}
+	for version := range versions {
+		fsys[version+".json"] = &fstest.MapFile{Data: []byte(`{}`)}
+	}
+
+	validator, err := NewValidationService(append([]Option{WithoutEmbeddedSchemas(), WithSchemaFS(fsys)}, opts...)...)
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	return validator
+}
+
+func TestStructuralSchemaVersion(t *testing.T) {
+	validator := newVersionsService(t, map[string]bool{"v0.5.0": true, "v0.5.1": true, "v0.6.0": true})
+
+	extensions := func(names ...string) []any {
+		items := make([]any, 0, len(names))
+		for _, name := range names {
+			items = append(items, map[string]any{"name": name})
+		}
+
+		return items
+	}
+
+	tests := []struct {
+		name     string
+		document map[string]any
+		want     string
+	}{
+		{name: "domains", document: map[string]any{"domains": []any{}}, want: "v0.6.0"},
+		{name: "runtime extension", document: map[string]any{"extensions": extensions("runtime/mcp")}, want: "v0.6.0"},
+		{name: "legacy feature", document: map[string]any{"extensions": extensions("schema.oasf.agntcy.org/features/runtime/mcp")}, want: "v0.5.1"},
+		{name: "legacy skill", document: map[string]any{"skills": extensions("schema.oasf.agntcy.org/skills/summarization")}, want: "v0.5.1"},
+		{name: "no version specific fields", document: map[string]any{"name": "example", "extensions": extensions("custom")}},
+		{name: "as many fields of each version", document: map[string]any{"domains": []any{}, "skills": extensions("schema.oasf.agntcy.org/skills/summarization")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := validator.structuralSchemaVersion(tt.document)
+			if got != tt.want || ok != (tt.want != "") {
+				t.Errorf("got %q, %v, want %q", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+// TestDetectSchemaVersionOfOtherLines detects versions whose line cannot be inferred from the structure of records.
+func TestDetectSchemaVersionOfOtherLines(t *testing.T) {
+	schemas := fstest.MapFS{
+		"v1.0.0.json": {Data: []byte(`{"required": ["name"], "properties": {"name": {"type": "string"}}}`)},
+		"v2.0.0.json": {Data: []byte(`{"required": ["name", "domains"]}`)},
+		"v2.1.0.json": {Data: []byte(`{"required": ["name", "domains"]}`)},
+	}
+
+	validator, err := NewValidationService(WithoutEmbeddedSchemas(), WithSchemaFS(schemas))
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		document any
+		want     string
+	}{
+		{name: "older version", document: map[string]any{"name": "example"}, want: "v1.0.0"},
+		{name: "latest of equal versions", document: map[string]any{"name": "example", "domains": []any{}}, want: "v2.1.0"},
+		{name: "fewest errors", document: map[string]any{}, want: "v1.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validator.detectSchemaVersion(tt.document)
+			if err != nil {
+				t.Fatalf("failed to detect schema version: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got version %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectEmbeddedSchemaVersion(t *testing.T) {
+	validator, err := NewValidationService()
+	if err != nil {
+		t.Fatalf("failed to create validation service: %v", err)
+	}
+
+	for _, version := range validator.SchemaVersions() {
+		t.Run(version, func(t *testing.T) {
+			data, err := os.ReadFile("testdata/record_" + version + ".json")
+			if err != nil {
+				t.Fatalf("failed to read record: %v", err)
+			}
+
+			var document map[string]any
+			if err := json.Unmarshal(data, &document); err != nil {
+				t.Fatalf("failed to decode record: %v", err)
+			}
+
+			delete(document, "schema_version")
+
+			got, err := validator.detectSchemaVersion(document)
+			if err != nil {
+				t.Fatalf("failed to detect schema version: %v", err)
+			}
+
+			if got != version {
+				t.Errorf("got version %s, want %s", got, version)
+			}
+		})
+	}
+}